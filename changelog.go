@@ -0,0 +1,141 @@
+package licensedb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Diff lists changes between two versions of SPDX license list.
+type Diff struct {
+	// List versions, empty if unknown
+	From string `json:"from"`
+	To   string `json:"to"`
+	// New license IDs
+	Added []string `json:"added"`
+	// License and exception IDs that are gone
+	Removed []string `json:"removed"`
+	// License and exception IDs that became deprecated
+	Deprecated []string `json:"deprecated"`
+	// New exception IDs
+	ExceptionsAdded []string `json:"exceptionsAdded"`
+	// Metadata changes of IDs present in both versions
+	Changed []Change `json:"changed"`
+	// Whether metadata was compared. It isn't if either list was
+	// generated without metadata; then Changed is always empty.
+	MetadataCompared bool `json:"metadataCompared"`
+}
+
+// Change is a change of metadata field of license or exception.
+type Change struct {
+	ID    string `json:"id"`
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// DiffVersions reports what changed between license lists of a and b,
+// where a is the older one. Metadata changes are reported only if both
+// databases have metadata, see Diff.MetadataCompared. All lists are
+// sorted by ID.
+func DiffVersions(a, b *DB) Diff {
+	d := Diff{
+		From:            a.ListVersion(),
+		To:              b.ListVersion(),
+		Added:           []string{},
+		Removed:         []string{},
+		Deprecated:      []string{},
+		ExceptionsAdded: []string{},
+		Changed:         []Change{},
+	}
+	old := make(map[string]License)
+	for _, l := range a.Query() {
+		old[l.ID] = l
+	}
+	withMeta := a.HasMetadata() && b.HasMetadata()
+	d.MetadataCompared = withMeta
+	for _, l := range b.Query() {
+		prev, ok := old[l.ID]
+		delete(old, l.ID)
+		switch {
+		case !ok && l.IsException:
+			d.ExceptionsAdded = append(d.ExceptionsAdded, l.ID)
+		case !ok:
+			d.Added = append(d.Added, l.ID)
+		case l.Deprecated && !prev.Deprecated:
+			d.Deprecated = append(d.Deprecated, l.ID)
+		}
+		if ok && withMeta {
+			d.Changed = append(d.Changed, diffMeta(prev, l)...)
+		}
+	}
+	for _, l := range a.Query() {
+		if _, ok := old[l.ID]; ok {
+			d.Removed = append(d.Removed, l.ID)
+		}
+	}
+	return d
+}
+
+func diffMeta(a, b License) []Change {
+	changes := []Change{}
+	add := func(field, old, new string) {
+		if old != new {
+			changes = append(changes, Change{a.ID, field, old, new})
+		}
+	}
+	add("Name", a.Name, b.Name)
+	add("OSIApproved", strconv.FormatBool(a.OSIApproved), strconv.FormatBool(b.OSIApproved))
+	add("FSFLibre", strconv.FormatBool(a.FSFLibre), strconv.FormatBool(b.FSFLibre))
+	if a.Deprecated && !b.Deprecated {
+		add("Deprecated", "true", "false")
+	}
+	return changes
+}
+
+// Empty reports whether there are no changes.
+func (d Diff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 &&
+		len(d.Deprecated) == 0 && len(d.ExceptionsAdded) == 0 &&
+		len(d.Changed) == 0
+}
+
+// String returns human-readable changelog.
+func (d Diff) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "SPDX license list %s -> %s\n", versionOrUnknown(d.From), versionOrUnknown(d.To))
+	if !d.MetadataCompared {
+		b.WriteString("\nMetadata like names and OSI approval is not compared: one of lists has no metadata\n")
+	}
+	if d.Empty() {
+		b.WriteString("\nNo changes\n")
+		return b.String()
+	}
+	section := func(title string, ids []string) {
+		if len(ids) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n%s:\n", title)
+		for _, id := range ids {
+			fmt.Fprintf(&b, "  %s\n", id)
+		}
+	}
+	section("Added licenses", d.Added)
+	section("Added exceptions", d.ExceptionsAdded)
+	section("Deprecated", d.Deprecated)
+	section("Removed", d.Removed)
+	if len(d.Changed) > 0 {
+		b.WriteString("\nChanged:\n")
+		for _, c := range d.Changed {
+			fmt.Fprintf(&b, "  %s: %s %q -> %q\n", c.ID, c.Field, c.Old, c.New)
+		}
+	}
+	return b.String()
+}
+
+func versionOrUnknown(v string) string {
+	if v == "" {
+		return "(unknown)"
+	}
+	return v
+}
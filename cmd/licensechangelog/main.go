@@ -0,0 +1,63 @@
+// Command licensechangelog writes human-readable and JSON changelog
+// between embedded SPDX license list and license-list-data release
+// archive on disk.
+//
+// Usage:
+//
+//	licensechangelog [-version 3.27] [-out changelog] ARCHIVE
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/asciimoth/licensedb"
+)
+
+func fatalf(msg string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, msg+"\n", args...)
+	os.Exit(1)
+}
+
+func main() {
+	version := flag.String("version", "", "embedded license list version to compare with, default one if empty")
+	out := flag.String("out", "changelog", "changelog files to write, .txt and .json extensions are appended")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] ARCHIVE\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	old := licensedb.Default()
+	if *version != "" {
+		var err error
+		if old, err = licensedb.Version(*version); err != nil {
+			fatalf("%v", err)
+		}
+	}
+	new, err := licensedb.OpenZip(flag.Arg(0))
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	diff := licensedb.DiffVersions(old, new)
+	if !diff.MetadataCompared {
+		fmt.Fprintln(os.Stderr, "warning: metadata is not compared, one of lists has no metadata")
+	}
+	data, err := json.MarshalIndent(diff, "", "  ")
+	if err != nil {
+		fatalf("%v", err)
+	}
+	if err := os.WriteFile(*out+".json", append(data, '\n'), 0o644); err != nil {
+		fatalf("%v", err)
+	}
+	if err := os.WriteFile(*out+".txt", []byte(diff.String()), 0o644); err != nil {
+		fatalf("%v", err)
+	}
+}
@@ -0,0 +1,58 @@
+// Command licensediff shows how text of license or exception changed
+// between embedded SPDX license list and license-list-data release
+// archive on disk.
+//
+// Usage:
+//
+//	licensediff [-version 3.27] [-words] ID ARCHIVE
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/asciimoth/licensedb"
+)
+
+func fatalf(msg string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, msg+"\n", args...)
+	os.Exit(1)
+}
+
+func main() {
+	version := flag.String("version", "", "embedded license list version to compare with, default one if empty")
+	words := flag.Bool("words", false, "show word-level diff instead of unified one")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] ID ARCHIVE\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	id, path := flag.Arg(0), flag.Arg(1)
+
+	old := licensedb.Default()
+	if *version != "" {
+		var err error
+		if old, err = licensedb.Version(*version); err != nil {
+			fatalf("%v", err)
+		}
+	}
+	new, err := licensedb.OpenZip(path)
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	diff := licensedb.DiffText[string]
+	if *words {
+		diff = licensedb.DiffTextWords[string]
+	}
+	out, err := diff(old, new, id)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	fmt.Print(out)
+}
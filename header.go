@@ -0,0 +1,157 @@
+package licensedb
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+// Vars are values of placeholders in standard license headers.
+type Vars struct {
+	// Copyright year or range of years, like "2024" or "2020-2024"
+	Year string
+	// Copyright holder, like "Jane Doe"
+	Holder string
+}
+
+// Header returns standard header of license id to put in source files,
+// like the one of Apache-2.0, with placeholders of copyright year and
+// holder filled from vars. Empty fields of vars keep placeholders.
+// Alternative forms of ID like "apache2" are accepted.
+func (db *DB) Header(id string, vars Vars) (string, error) {
+	m, ok := db.core.GetMeta(id)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownID, id)
+	}
+	header := strings.TrimSpace(m.Header)
+	if header == "" {
+		return "", fmt.Errorf("%w: %s", ErrNoHeader, m.ID)
+	}
+	return internal.FillHeader(header, vars.Year, vars.Holder), nil
+}
+
+// Header is a wrapper around Default().Header.
+func Header[S ~string](id S, vars Vars) (string, error) {
+	return Default().Header(string(id), vars)
+}
+
+// CommentStyle is comment syntax of a programming language.
+type CommentStyle struct {
+	// Start of line comments, like "//" or "#".
+	// Empty for languages with block comments only.
+	Line string
+	// Delimiters of block comment, like "/*" and " */", and start of
+	// lines inside it, like " *"
+	Start, Prefix, End string
+}
+
+var (
+	// Go, Rust, JavaScript, TypeScript, Java, Kotlin, Swift, C#, Dart
+	SlashStyle = CommentStyle{Line: "//"}
+	// C, C++, Objective-C, CSS
+	CStyle = CommentStyle{Start: "/*", Prefix: " *", End: " */"}
+	// Python, shell, Ruby, Perl, R, YAML, TOML, Makefile, Dockerfile
+	HashStyle = CommentStyle{Line: "#"}
+	// HTML, XML, SVG, Markdown
+	HTMLStyle = CommentStyle{Start: "<!--", End: "-->"}
+	// SQL, Lua, Haskell, Ada
+	DashStyle = CommentStyle{Line: "--"}
+	// Lisp, Emacs Lisp, Scheme, Clojure
+	SemicolonStyle = CommentStyle{Line: ";;"}
+	// TeX, Erlang, MATLAB
+	PercentStyle = CommentStyle{Line: "%"}
+)
+
+// Comment styles by language name and file extension
+var commentStyles = map[string]CommentStyle{
+	"go": SlashStyle, "rust": SlashStyle, "rs": SlashStyle,
+	"javascript": SlashStyle, "js": SlashStyle, "mjs": SlashStyle, "jsx": SlashStyle,
+	"typescript": SlashStyle, "ts": SlashStyle, "tsx": SlashStyle,
+	"java": SlashStyle, "kotlin": SlashStyle, "kt": SlashStyle, "kts": SlashStyle,
+	"swift": SlashStyle, "csharp": SlashStyle, "cs": SlashStyle,
+	"dart": SlashStyle, "scala": SlashStyle, "zig": SlashStyle, "proto": SlashStyle,
+
+	"c": CStyle, "h": CStyle, "cpp": CStyle, "c++": CStyle, "cc": CStyle,
+	"cxx": CStyle, "hpp": CStyle, "hh": CStyle, "objc": CStyle,
+	"css": CStyle, "scss": CStyle, "less": CStyle,
+
+	"python": HashStyle, "py": HashStyle, "shell": HashStyle, "sh": HashStyle,
+	"bash": HashStyle, "zsh": HashStyle, "fish": HashStyle,
+	"ruby": HashStyle, "rb": HashStyle, "perl": HashStyle, "pl": HashStyle,
+	"r": HashStyle, "yaml": HashStyle, "yml": HashStyle, "toml": HashStyle,
+	"nix": HashStyle, "cmake": HashStyle, "makefile": HashStyle,
+	"dockerfile": HashStyle, "nim": HashStyle, "elixir": HashStyle, "ex": HashStyle,
+	"exs": HashStyle, "powershell": HashStyle, "ps1": HashStyle,
+
+	"html": HTMLStyle, "htm": HTMLStyle, "xml": HTMLStyle, "svg": HTMLStyle,
+	"markdown": HTMLStyle, "md": HTMLStyle, "vue": HTMLStyle,
+
+	"sql": DashStyle, "lua": DashStyle, "haskell": DashStyle, "hs": DashStyle,
+	"ada": DashStyle, "adb": DashStyle, "ads": DashStyle,
+
+	"lisp": SemicolonStyle, "el": SemicolonStyle, "elisp": SemicolonStyle,
+	"scheme": SemicolonStyle, "scm": SemicolonStyle, "clojure": SemicolonStyle,
+	"clj": SemicolonStyle,
+
+	"tex": PercentStyle, "latex": PercentStyle, "erlang": PercentStyle,
+	"erl": PercentStyle, "matlab": PercentStyle,
+}
+
+// CommentStyleFor returns comment style for language name like "go" or
+// "python", file extension like ".py" or file name like "main.c" or
+// "Makefile". Case is ignored.
+func CommentStyleFor(lang string) (CommentStyle, bool) {
+	lang = strings.ToLower(lang)
+	if s, ok := commentStyles[lang]; ok {
+		return s, true
+	}
+	s, ok := commentStyles[strings.TrimPrefix(path.Ext(lang), ".")]
+	if !ok {
+		s, ok = commentStyles[path.Base(lang)]
+	}
+	return s, ok
+}
+
+// Comment returns text wrapped to lines of at most width runes including
+// comment delimiters, formatted as comment of style s. Non-positive width
+// disables wrapping.
+func (s CommentStyle) Comment(text string, width int) string {
+	prefix := s.Line
+	if prefix == "" {
+		prefix = s.Prefix
+	}
+	textWidth := width
+	if width > 0 && prefix != "" {
+		// Prefix is followed by space
+		textWidth = max(width-utf8.RuneCountInString(prefix)-1, 1)
+	}
+	var b strings.Builder
+	if s.Line == "" {
+		b.WriteString(s.Start + "\n")
+	}
+	for _, line := range internal.WrapText(text, textWidth) {
+		switch {
+		case prefix == "":
+			b.WriteString(line)
+		case line == "":
+			b.WriteString(prefix)
+		default:
+			b.WriteString(prefix + " " + line)
+		}
+		b.WriteString("\n")
+	}
+	if s.Line == "" {
+		b.WriteString(s.End + "\n")
+	}
+	return b.String()
+}
+
+// Insert returns src with text put at its top as comment of style s,
+// wrapped like Comment does. Shebang, build constraints, XML and encoding
+// declarations stay first.
+func (s CommentStyle) Insert(src, text string, width int) string {
+	return internal.InsertHeader(src, s.Comment(text, width))
+}
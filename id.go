@@ -0,0 +1,66 @@
+package licensedb
+
+import "io"
+
+// ID is SPDX license or exception ID. There are constants for all IDs of
+// the default embedded license list, like MIT or GPL_3_0_or_later.
+// Top-level functions that take an ID, like Lookup, Text or Header, accept
+// both IDs and strings; methods of DB take strings, so use id.String()
+// with them.
+type ID string
+
+// String returns id as string.
+func (id ID) String() string {
+	return string(id)
+}
+
+// License returns license or exception id from default database.
+func (id ID) License() (License, bool) {
+	return Lookup(id)
+}
+
+// Text returns text of license or exception id from default database.
+func (id ID) Text() (string, error) {
+	return Text(id)
+}
+
+// OpenText opens text of license or exception id from default database
+// for streaming.
+func (id ID) OpenText() (io.ReadCloser, error) {
+	return OpenText(id)
+}
+
+// SameText reports whether id and other have identical texts, like
+// GPL_3_0_only and GPL_3_0_or_later.
+func (id ID) SameText(other ID) bool {
+	return SameText(id, other)
+}
+
+// ShortForms returns alternative short names of id, shortest first.
+func (id ID) ShortForms() []string {
+	return ToShortForms(id)
+}
+
+// Template returns template of license or exception id from default
+// database.
+func (id ID) Template() (Template, error) {
+	return Default().Template(string(id))
+}
+
+// Header returns standard header of license id from default database
+// filled from vars.
+func (id ID) Header(vars Vars) (string, error) {
+	return Header(id, vars)
+}
+
+// IsException reports whether id is a license exception.
+func (id ID) IsException() bool {
+	l, ok := id.License()
+	return ok && l.IsException
+}
+
+// IsDeprecated reports whether id is deprecated by SPDX.
+func (id ID) IsDeprecated() bool {
+	l, ok := id.License()
+	return ok && l.Deprecated
+}
@@ -0,0 +1,817 @@
+// Code generated by genembed.go; DO NOT EDIT.
+
+package licensedb
+
+// Licenses and exceptions of SPDX license list 3.27.0
+const (
+	ID_0BSD          ID = "0BSD"
+	ID_389_exception ID = "389-exception"
+	ID_3D_Slicer_1_0 ID = "3D-Slicer-1.0"
+	AAL              ID = "AAL"
+	ADSL             ID = "ADSL"
+	AFL_1_1          ID = "AFL-1.1"
+	AFL_1_2          ID = "AFL-1.2"
+	AFL_2_0          ID = "AFL-2.0"
+	AFL_2_1          ID = "AFL-2.1"
+	AFL_3_0          ID = "AFL-3.0"
+	// Deprecated: ID is deprecated by SPDX.
+	AGPL_1_0          ID = "AGPL-1.0"
+	AGPL_1_0_only     ID = "AGPL-1.0-only"
+	AGPL_1_0_or_later ID = "AGPL-1.0-or-later"
+	// Deprecated: ID is deprecated by SPDX.
+	AGPL_3_0                             ID = "AGPL-3.0"
+	AGPL_3_0_only                        ID = "AGPL-3.0-only"
+	AGPL_3_0_or_later                    ID = "AGPL-3.0-or-later"
+	AMD_newlib                           ID = "AMD-newlib"
+	AMDPLPA                              ID = "AMDPLPA"
+	AML                                  ID = "AML"
+	AML_glslang                          ID = "AML-glslang"
+	AMPAS                                ID = "AMPAS"
+	ANTLR_PD                             ID = "ANTLR-PD"
+	ANTLR_PD_fallback                    ID = "ANTLR-PD-fallback"
+	APAFML                               ID = "APAFML"
+	APL_1_0                              ID = "APL-1.0"
+	APSL_1_0                             ID = "APSL-1.0"
+	APSL_1_1                             ID = "APSL-1.1"
+	APSL_1_2                             ID = "APSL-1.2"
+	APSL_2_0                             ID = "APSL-2.0"
+	ASWF_Digital_Assets_1_0              ID = "ASWF-Digital-Assets-1.0"
+	ASWF_Digital_Assets_1_1              ID = "ASWF-Digital-Assets-1.1"
+	Abstyles                             ID = "Abstyles"
+	AdaCore_doc                          ID = "AdaCore-doc"
+	Adobe_2006                           ID = "Adobe-2006"
+	Adobe_Display_PostScript             ID = "Adobe-Display-PostScript"
+	Adobe_Glyph                          ID = "Adobe-Glyph"
+	Adobe_Utopia                         ID = "Adobe-Utopia"
+	Afmparse                             ID = "Afmparse"
+	Aladdin                              ID = "Aladdin"
+	Apache_1_0                           ID = "Apache-1.0"
+	Apache_1_1                           ID = "Apache-1.1"
+	Apache_2_0                           ID = "Apache-2.0"
+	App_s2p                              ID = "App-s2p"
+	Arphic_1999                          ID = "Arphic-1999"
+	Artistic_1_0                         ID = "Artistic-1.0"
+	Artistic_1_0_Perl                    ID = "Artistic-1.0-Perl"
+	Artistic_1_0_cl8                     ID = "Artistic-1.0-cl8"
+	Artistic_2_0                         ID = "Artistic-2.0"
+	Artistic_dist                        ID = "Artistic-dist"
+	Aspell_RU                            ID = "Aspell-RU"
+	Asterisk_exception                   ID = "Asterisk-exception"
+	Asterisk_linking_protocols_exception ID = "Asterisk-linking-protocols-exception"
+	Autoconf_exception_2_0               ID = "Autoconf-exception-2.0"
+	Autoconf_exception_3_0               ID = "Autoconf-exception-3.0"
+	Autoconf_exception_generic           ID = "Autoconf-exception-generic"
+	Autoconf_exception_generic_3_0       ID = "Autoconf-exception-generic-3.0"
+	Autoconf_exception_macro             ID = "Autoconf-exception-macro"
+	BSD_1_Clause                         ID = "BSD-1-Clause"
+	BSD_2_Clause                         ID = "BSD-2-Clause"
+	BSD_2_Clause_Darwin                  ID = "BSD-2-Clause-Darwin"
+	// Deprecated: ID is deprecated by SPDX.
+	BSD_2_Clause_FreeBSD ID = "BSD-2-Clause-FreeBSD"
+	// Deprecated: ID is deprecated by SPDX.
+	BSD_2_Clause_NetBSD                  ID = "BSD-2-Clause-NetBSD"
+	BSD_2_Clause_Patent                  ID = "BSD-2-Clause-Patent"
+	BSD_2_Clause_Views                   ID = "BSD-2-Clause-Views"
+	BSD_2_Clause_first_lines             ID = "BSD-2-Clause-first-lines"
+	BSD_2_Clause_pkgconf_disclaimer      ID = "BSD-2-Clause-pkgconf-disclaimer"
+	BSD_3_Clause                         ID = "BSD-3-Clause"
+	BSD_3_Clause_Attribution             ID = "BSD-3-Clause-Attribution"
+	BSD_3_Clause_Clear                   ID = "BSD-3-Clause-Clear"
+	BSD_3_Clause_HP                      ID = "BSD-3-Clause-HP"
+	BSD_3_Clause_LBNL                    ID = "BSD-3-Clause-LBNL"
+	BSD_3_Clause_Modification            ID = "BSD-3-Clause-Modification"
+	BSD_3_Clause_No_Military_License     ID = "BSD-3-Clause-No-Military-License"
+	BSD_3_Clause_No_Nuclear_License      ID = "BSD-3-Clause-No-Nuclear-License"
+	BSD_3_Clause_No_Nuclear_License_2014 ID = "BSD-3-Clause-No-Nuclear-License-2014"
+	BSD_3_Clause_No_Nuclear_Warranty     ID = "BSD-3-Clause-No-Nuclear-Warranty"
+	BSD_3_Clause_Open_MPI                ID = "BSD-3-Clause-Open-MPI"
+	BSD_3_Clause_Sun                     ID = "BSD-3-Clause-Sun"
+	BSD_3_Clause_acpica                  ID = "BSD-3-Clause-acpica"
+	BSD_3_Clause_flex                    ID = "BSD-3-Clause-flex"
+	BSD_4_Clause                         ID = "BSD-4-Clause"
+	BSD_4_Clause_Shortened               ID = "BSD-4-Clause-Shortened"
+	BSD_4_Clause_UC                      ID = "BSD-4-Clause-UC"
+	BSD_4_3RENO                          ID = "BSD-4.3RENO"
+	BSD_4_3TAHOE                         ID = "BSD-4.3TAHOE"
+	BSD_Advertising_Acknowledgement      ID = "BSD-Advertising-Acknowledgement"
+	BSD_Attribution_HPND_disclaimer      ID = "BSD-Attribution-HPND-disclaimer"
+	BSD_Inferno_Nettverk                 ID = "BSD-Inferno-Nettverk"
+	BSD_Protection                       ID = "BSD-Protection"
+	BSD_Source_Code                      ID = "BSD-Source-Code"
+	BSD_Source_beginning_file            ID = "BSD-Source-beginning-file"
+	BSD_Systemics                        ID = "BSD-Systemics"
+	BSD_Systemics_W3Works                ID = "BSD-Systemics-W3Works"
+	BSL_1_0                              ID = "BSL-1.0"
+	BUSL_1_1                             ID = "BUSL-1.1"
+	Baekmuk                              ID = "Baekmuk"
+	Bahyph                               ID = "Bahyph"
+	Barr                                 ID = "Barr"
+	Beerware                             ID = "Beerware"
+	Bison_exception_1_24                 ID = "Bison-exception-1.24"
+	Bison_exception_2_2                  ID = "Bison-exception-2.2"
+	BitTorrent_1_0                       ID = "BitTorrent-1.0"
+	BitTorrent_1_1                       ID = "BitTorrent-1.1"
+	Bitstream_Charter                    ID = "Bitstream-Charter"
+	Bitstream_Vera                       ID = "Bitstream-Vera"
+	BlueOak_1_0_0                        ID = "BlueOak-1.0.0"
+	Boehm_GC                             ID = "Boehm-GC"
+	Boehm_GC_without_fee                 ID = "Boehm-GC-without-fee"
+	Bootloader_exception                 ID = "Bootloader-exception"
+	Borceux                              ID = "Borceux"
+	Brian_Gladman_2_Clause               ID = "Brian-Gladman-2-Clause"
+	Brian_Gladman_3_Clause               ID = "Brian-Gladman-3-Clause"
+	C_UDA_1_0                            ID = "C-UDA-1.0"
+	CAL_1_0                              ID = "CAL-1.0"
+	CAL_1_0_Combined_Work_Exception      ID = "CAL-1.0-Combined-Work-Exception"
+	CATOSL_1_1                           ID = "CATOSL-1.1"
+	CC_BY_1_0                            ID = "CC-BY-1.0"
+	CC_BY_2_0                            ID = "CC-BY-2.0"
+	CC_BY_2_5                            ID = "CC-BY-2.5"
+	CC_BY_2_5_AU                         ID = "CC-BY-2.5-AU"
+	CC_BY_3_0                            ID = "CC-BY-3.0"
+	CC_BY_3_0_AT                         ID = "CC-BY-3.0-AT"
+	CC_BY_3_0_AU                         ID = "CC-BY-3.0-AU"
+	CC_BY_3_0_DE                         ID = "CC-BY-3.0-DE"
+	CC_BY_3_0_IGO                        ID = "CC-BY-3.0-IGO"
+	CC_BY_3_0_NL                         ID = "CC-BY-3.0-NL"
+	CC_BY_3_0_US                         ID = "CC-BY-3.0-US"
+	CC_BY_4_0                            ID = "CC-BY-4.0"
+	CC_BY_NC_1_0                         ID = "CC-BY-NC-1.0"
+	CC_BY_NC_2_0                         ID = "CC-BY-NC-2.0"
+	CC_BY_NC_2_5                         ID = "CC-BY-NC-2.5"
+	CC_BY_NC_3_0                         ID = "CC-BY-NC-3.0"
+	CC_BY_NC_3_0_DE                      ID = "CC-BY-NC-3.0-DE"
+	CC_BY_NC_4_0                         ID = "CC-BY-NC-4.0"
+	CC_BY_NC_ND_1_0                      ID = "CC-BY-NC-ND-1.0"
+	CC_BY_NC_ND_2_0                      ID = "CC-BY-NC-ND-2.0"
+	CC_BY_NC_ND_2_5                      ID = "CC-BY-NC-ND-2.5"
+	CC_BY_NC_ND_3_0                      ID = "CC-BY-NC-ND-3.0"
+	CC_BY_NC_ND_3_0_DE                   ID = "CC-BY-NC-ND-3.0-DE"
+	CC_BY_NC_ND_3_0_IGO                  ID = "CC-BY-NC-ND-3.0-IGO"
+	CC_BY_NC_ND_4_0                      ID = "CC-BY-NC-ND-4.0"
+	CC_BY_NC_SA_1_0                      ID = "CC-BY-NC-SA-1.0"
+	CC_BY_NC_SA_2_0                      ID = "CC-BY-NC-SA-2.0"
+	CC_BY_NC_SA_2_0_DE                   ID = "CC-BY-NC-SA-2.0-DE"
+	CC_BY_NC_SA_2_0_FR                   ID = "CC-BY-NC-SA-2.0-FR"
+	CC_BY_NC_SA_2_0_UK                   ID = "CC-BY-NC-SA-2.0-UK"
+	CC_BY_NC_SA_2_5                      ID = "CC-BY-NC-SA-2.5"
+	CC_BY_NC_SA_3_0                      ID = "CC-BY-NC-SA-3.0"
+	CC_BY_NC_SA_3_0_DE                   ID = "CC-BY-NC-SA-3.0-DE"
+	CC_BY_NC_SA_3_0_IGO                  ID = "CC-BY-NC-SA-3.0-IGO"
+	CC_BY_NC_SA_4_0                      ID = "CC-BY-NC-SA-4.0"
+	CC_BY_ND_1_0                         ID = "CC-BY-ND-1.0"
+	CC_BY_ND_2_0                         ID = "CC-BY-ND-2.0"
+	CC_BY_ND_2_5                         ID = "CC-BY-ND-2.5"
+	CC_BY_ND_3_0                         ID = "CC-BY-ND-3.0"
+	CC_BY_ND_3_0_DE                      ID = "CC-BY-ND-3.0-DE"
+	CC_BY_ND_4_0                         ID = "CC-BY-ND-4.0"
+	CC_BY_SA_1_0                         ID = "CC-BY-SA-1.0"
+	CC_BY_SA_2_0                         ID = "CC-BY-SA-2.0"
+	CC_BY_SA_2_0_UK                      ID = "CC-BY-SA-2.0-UK"
+	CC_BY_SA_2_1_JP                      ID = "CC-BY-SA-2.1-JP"
+	CC_BY_SA_2_5                         ID = "CC-BY-SA-2.5"
+	CC_BY_SA_3_0                         ID = "CC-BY-SA-3.0"
+	CC_BY_SA_3_0_AT                      ID = "CC-BY-SA-3.0-AT"
+	CC_BY_SA_3_0_DE                      ID = "CC-BY-SA-3.0-DE"
+	CC_BY_SA_3_0_IGO                     ID = "CC-BY-SA-3.0-IGO"
+	CC_BY_SA_4_0                         ID = "CC-BY-SA-4.0"
+	CC_PDDC                              ID = "CC-PDDC"
+	CC_PDM_1_0                           ID = "CC-PDM-1.0"
+	CC_SA_1_0                            ID = "CC-SA-1.0"
+	CC0_1_0                              ID = "CC0-1.0"
+	CDDL_1_0                             ID = "CDDL-1.0"
+	CDDL_1_1                             ID = "CDDL-1.1"
+	CDL_1_0                              ID = "CDL-1.0"
+	CDLA_Permissive_1_0                  ID = "CDLA-Permissive-1.0"
+	CDLA_Permissive_2_0                  ID = "CDLA-Permissive-2.0"
+	CDLA_Sharing_1_0                     ID = "CDLA-Sharing-1.0"
+	CECILL_1_0                           ID = "CECILL-1.0"
+	CECILL_1_1                           ID = "CECILL-1.1"
+	CECILL_2_0                           ID = "CECILL-2.0"
+	CECILL_2_1                           ID = "CECILL-2.1"
+	CECILL_B                             ID = "CECILL-B"
+	CECILL_C                             ID = "CECILL-C"
+	CERN_OHL_1_1                         ID = "CERN-OHL-1.1"
+	CERN_OHL_1_2                         ID = "CERN-OHL-1.2"
+	CERN_OHL_P_2_0                       ID = "CERN-OHL-P-2.0"
+	CERN_OHL_S_2_0                       ID = "CERN-OHL-S-2.0"
+	CERN_OHL_W_2_0                       ID = "CERN-OHL-W-2.0"
+	CFITSIO                              ID = "CFITSIO"
+	CGAL_linking_exception               ID = "CGAL-linking-exception"
+	CLISP_exception_2_0                  ID = "CLISP-exception-2.0"
+	CMU_Mach                             ID = "CMU-Mach"
+	CMU_Mach_nodoc                       ID = "CMU-Mach-nodoc"
+	CNRI_Jython                          ID = "CNRI-Jython"
+	CNRI_Python                          ID = "CNRI-Python"
+	CNRI_Python_GPL_Compatible           ID = "CNRI-Python-GPL-Compatible"
+	COIL_1_0                             ID = "COIL-1.0"
+	CPAL_1_0                             ID = "CPAL-1.0"
+	CPL_1_0                              ID = "CPL-1.0"
+	CPOL_1_02                            ID = "CPOL-1.02"
+	CUA_OPL_1_0                          ID = "CUA-OPL-1.0"
+	Caldera                              ID = "Caldera"
+	Caldera_no_preamble                  ID = "Caldera-no-preamble"
+	Catharon                             ID = "Catharon"
+	ClArtistic                           ID = "ClArtistic"
+	Classpath_exception_2_0              ID = "Classpath-exception-2.0"
+	Clips                                ID = "Clips"
+	Community_Spec_1_0                   ID = "Community-Spec-1.0"
+	Condor_1_1                           ID = "Condor-1.1"
+	Cornell_Lossless_JPEG                ID = "Cornell-Lossless-JPEG"
+	Cronyx                               ID = "Cronyx"
+	Crossword                            ID = "Crossword"
+	CryptoSwift                          ID = "CryptoSwift"
+	CrystalStacker                       ID = "CrystalStacker"
+	Cube                                 ID = "Cube"
+	D_FSL_1_0                            ID = "D-FSL-1.0"
+	DEC_3_Clause                         ID = "DEC-3-Clause"
+	DL_DE_BY_2_0                         ID = "DL-DE-BY-2.0"
+	DL_DE_ZERO_2_0                       ID = "DL-DE-ZERO-2.0"
+	DOC                                  ID = "DOC"
+	DRL_1_0                              ID = "DRL-1.0"
+	DRL_1_1                              ID = "DRL-1.1"
+	DSDP                                 ID = "DSDP"
+	DigiRule_FOSS_exception              ID = "DigiRule-FOSS-exception"
+	Digia_Qt_LGPL_exception_1_1          ID = "Digia-Qt-LGPL-exception-1.1"
+	DocBook_DTD                          ID = "DocBook-DTD"
+	DocBook_Schema                       ID = "DocBook-Schema"
+	DocBook_Stylesheet                   ID = "DocBook-Stylesheet"
+	DocBook_XML                          ID = "DocBook-XML"
+	Dotseqn                              ID = "Dotseqn"
+	ECL_1_0                              ID = "ECL-1.0"
+	ECL_2_0                              ID = "ECL-2.0"
+	EFL_1_0                              ID = "EFL-1.0"
+	EFL_2_0                              ID = "EFL-2.0"
+	EPICS                                ID = "EPICS"
+	EPL_1_0                              ID = "EPL-1.0"
+	EPL_2_0                              ID = "EPL-2.0"
+	EUDatagrid                           ID = "EUDatagrid"
+	EUPL_1_0                             ID = "EUPL-1.0"
+	EUPL_1_1                             ID = "EUPL-1.1"
+	EUPL_1_2                             ID = "EUPL-1.2"
+	Elastic_2_0                          ID = "Elastic-2.0"
+	Entessa                              ID = "Entessa"
+	ErlPL_1_1                            ID = "ErlPL-1.1"
+	Eurosym                              ID = "Eurosym"
+	FBM                                  ID = "FBM"
+	FDK_AAC                              ID = "FDK-AAC"
+	FLTK_exception                       ID = "FLTK-exception"
+	FSFAP                                ID = "FSFAP"
+	FSFAP_no_warranty_disclaimer         ID = "FSFAP-no-warranty-disclaimer"
+	FSFUL                                ID = "FSFUL"
+	FSFULLR                              ID = "FSFULLR"
+	FSFULLRSD                            ID = "FSFULLRSD"
+	FSFULLRWD                            ID = "FSFULLRWD"
+	FSL_1_1_ALv2                         ID = "FSL-1.1-ALv2"
+	FSL_1_1_MIT                          ID = "FSL-1.1-MIT"
+	FTL                                  ID = "FTL"
+	Fair                                 ID = "Fair"
+	Fawkes_Runtime_exception             ID = "Fawkes-Runtime-exception"
+	Ferguson_Twofish                     ID = "Ferguson-Twofish"
+	Font_exception_2_0                   ID = "Font-exception-2.0"
+	Frameworx_1_0                        ID = "Frameworx-1.0"
+	FreeBSD_DOC                          ID = "FreeBSD-DOC"
+	FreeImage                            ID = "FreeImage"
+	Furuseth                             ID = "Furuseth"
+	GCC_exception_2_0                    ID = "GCC-exception-2.0"
+	GCC_exception_2_0_note               ID = "GCC-exception-2.0-note"
+	GCC_exception_3_1                    ID = "GCC-exception-3.1"
+	GCR_docs                             ID = "GCR-docs"
+	GD                                   ID = "GD"
+	// Deprecated: ID is deprecated by SPDX.
+	GFDL_1_1                        ID = "GFDL-1.1"
+	GFDL_1_1_invariants_only        ID = "GFDL-1.1-invariants-only"
+	GFDL_1_1_invariants_or_later    ID = "GFDL-1.1-invariants-or-later"
+	GFDL_1_1_no_invariants_only     ID = "GFDL-1.1-no-invariants-only"
+	GFDL_1_1_no_invariants_or_later ID = "GFDL-1.1-no-invariants-or-later"
+	GFDL_1_1_only                   ID = "GFDL-1.1-only"
+	GFDL_1_1_or_later               ID = "GFDL-1.1-or-later"
+	// Deprecated: ID is deprecated by SPDX.
+	GFDL_1_2                        ID = "GFDL-1.2"
+	GFDL_1_2_invariants_only        ID = "GFDL-1.2-invariants-only"
+	GFDL_1_2_invariants_or_later    ID = "GFDL-1.2-invariants-or-later"
+	GFDL_1_2_no_invariants_only     ID = "GFDL-1.2-no-invariants-only"
+	GFDL_1_2_no_invariants_or_later ID = "GFDL-1.2-no-invariants-or-later"
+	GFDL_1_2_only                   ID = "GFDL-1.2-only"
+	GFDL_1_2_or_later               ID = "GFDL-1.2-or-later"
+	// Deprecated: ID is deprecated by SPDX.
+	GFDL_1_3                        ID = "GFDL-1.3"
+	GFDL_1_3_invariants_only        ID = "GFDL-1.3-invariants-only"
+	GFDL_1_3_invariants_or_later    ID = "GFDL-1.3-invariants-or-later"
+	GFDL_1_3_no_invariants_only     ID = "GFDL-1.3-no-invariants-only"
+	GFDL_1_3_no_invariants_or_later ID = "GFDL-1.3-no-invariants-or-later"
+	GFDL_1_3_only                   ID = "GFDL-1.3-only"
+	GFDL_1_3_or_later               ID = "GFDL-1.3-or-later"
+	GL2PS                           ID = "GL2PS"
+	GLWTPL                          ID = "GLWTPL"
+	GNAT_exception                  ID = "GNAT-exception"
+	GNOME_examples_exception        ID = "GNOME-examples-exception"
+	GNU_compiler_exception          ID = "GNU-compiler-exception"
+	// Deprecated: ID is deprecated by SPDX.
+	GPL_1_0 ID = "GPL-1.0"
+	// Deprecated: ID is deprecated by SPDX.
+	GPL_1_0_plus     ID = "GPL-1.0+"
+	GPL_1_0_only     ID = "GPL-1.0-only"
+	GPL_1_0_or_later ID = "GPL-1.0-or-later"
+	// Deprecated: ID is deprecated by SPDX.
+	GPL_2_0 ID = "GPL-2.0"
+	// Deprecated: ID is deprecated by SPDX.
+	GPL_2_0_plus     ID = "GPL-2.0+"
+	GPL_2_0_only     ID = "GPL-2.0-only"
+	GPL_2_0_or_later ID = "GPL-2.0-or-later"
+	// Deprecated: ID is deprecated by SPDX.
+	GPL_2_0_with_GCC_exception ID = "GPL-2.0-with-GCC-exception"
+	// Deprecated: ID is deprecated by SPDX.
+	GPL_2_0_with_autoconf_exception ID = "GPL-2.0-with-autoconf-exception"
+	// Deprecated: ID is deprecated by SPDX.
+	GPL_2_0_with_bison_exception ID = "GPL-2.0-with-bison-exception"
+	// Deprecated: ID is deprecated by SPDX.
+	GPL_2_0_with_classpath_exception ID = "GPL-2.0-with-classpath-exception"
+	// Deprecated: ID is deprecated by SPDX.
+	GPL_2_0_with_font_exception ID = "GPL-2.0-with-font-exception"
+	// Deprecated: ID is deprecated by SPDX.
+	GPL_3_0 ID = "GPL-3.0"
+	// Deprecated: ID is deprecated by SPDX.
+	GPL_3_0_plus                     ID = "GPL-3.0+"
+	GPL_3_0_389_ds_base_exception    ID = "GPL-3.0-389-ds-base-exception"
+	GPL_3_0_interface_exception      ID = "GPL-3.0-interface-exception"
+	GPL_3_0_linking_exception        ID = "GPL-3.0-linking-exception"
+	GPL_3_0_linking_source_exception ID = "GPL-3.0-linking-source-exception"
+	GPL_3_0_only                     ID = "GPL-3.0-only"
+	GPL_3_0_or_later                 ID = "GPL-3.0-or-later"
+	// Deprecated: ID is deprecated by SPDX.
+	GPL_3_0_with_GCC_exception ID = "GPL-3.0-with-GCC-exception"
+	// Deprecated: ID is deprecated by SPDX.
+	GPL_3_0_with_autoconf_exception      ID = "GPL-3.0-with-autoconf-exception"
+	GPL_CC_1_0                           ID = "GPL-CC-1.0"
+	GStreamer_exception_2005             ID = "GStreamer-exception-2005"
+	GStreamer_exception_2008             ID = "GStreamer-exception-2008"
+	Game_Programming_Gems                ID = "Game-Programming-Gems"
+	Giftware                             ID = "Giftware"
+	Glide                                ID = "Glide"
+	Glulxe                               ID = "Glulxe"
+	Gmsh_exception                       ID = "Gmsh-exception"
+	Graphics_Gems                        ID = "Graphics-Gems"
+	Gutmann                              ID = "Gutmann"
+	HDF5                                 ID = "HDF5"
+	HIDAPI                               ID = "HIDAPI"
+	HP_1986                              ID = "HP-1986"
+	HP_1989                              ID = "HP-1989"
+	HPND                                 ID = "HPND"
+	HPND_DEC                             ID = "HPND-DEC"
+	HPND_Fenneberg_Livingston            ID = "HPND-Fenneberg-Livingston"
+	HPND_INRIA_IMAG                      ID = "HPND-INRIA-IMAG"
+	HPND_Intel                           ID = "HPND-Intel"
+	HPND_Kevlin_Henney                   ID = "HPND-Kevlin-Henney"
+	HPND_MIT_disclaimer                  ID = "HPND-MIT-disclaimer"
+	HPND_Markus_Kuhn                     ID = "HPND-Markus-Kuhn"
+	HPND_Netrek                          ID = "HPND-Netrek"
+	HPND_Pbmplus                         ID = "HPND-Pbmplus"
+	HPND_UC                              ID = "HPND-UC"
+	HPND_UC_export_US                    ID = "HPND-UC-export-US"
+	HPND_doc                             ID = "HPND-doc"
+	HPND_doc_sell                        ID = "HPND-doc-sell"
+	HPND_export_US                       ID = "HPND-export-US"
+	HPND_export_US_acknowledgement       ID = "HPND-export-US-acknowledgement"
+	HPND_export_US_modify                ID = "HPND-export-US-modify"
+	HPND_export2_US                      ID = "HPND-export2-US"
+	HPND_merchantability_variant         ID = "HPND-merchantability-variant"
+	HPND_sell_MIT_disclaimer_xserver     ID = "HPND-sell-MIT-disclaimer-xserver"
+	HPND_sell_regexpr                    ID = "HPND-sell-regexpr"
+	HPND_sell_variant                    ID = "HPND-sell-variant"
+	HPND_sell_variant_MIT_disclaimer     ID = "HPND-sell-variant-MIT-disclaimer"
+	HPND_sell_variant_MIT_disclaimer_rev ID = "HPND-sell-variant-MIT-disclaimer-rev"
+	HTMLTIDY                             ID = "HTMLTIDY"
+	HaskellReport                        ID = "HaskellReport"
+	Hippocratic_2_1                      ID = "Hippocratic-2.1"
+	IBM_pibs                             ID = "IBM-pibs"
+	ICU                                  ID = "ICU"
+	IEC_Code_Components_EULA             ID = "IEC-Code-Components-EULA"
+	IJG                                  ID = "IJG"
+	IJG_short                            ID = "IJG-short"
+	IPA                                  ID = "IPA"
+	IPL_1_0                              ID = "IPL-1.0"
+	ISC                                  ID = "ISC"
+	ISC_Veillard                         ID = "ISC-Veillard"
+	ImageMagick                          ID = "ImageMagick"
+	Imlib2                               ID = "Imlib2"
+	Independent_modules_exception        ID = "Independent-modules-exception"
+	Info_ZIP                             ID = "Info-ZIP"
+	Inner_Net_2_0                        ID = "Inner-Net-2.0"
+	InnoSetup                            ID = "InnoSetup"
+	Intel                                ID = "Intel"
+	Intel_ACPI                           ID = "Intel-ACPI"
+	Interbase_1_0                        ID = "Interbase-1.0"
+	JPL_image                            ID = "JPL-image"
+	JPNIC                                ID = "JPNIC"
+	JSON                                 ID = "JSON"
+	Jam                                  ID = "Jam"
+	JasPer_2_0                           ID = "JasPer-2.0"
+	Kastrup                              ID = "Kastrup"
+	Kazlib                               ID = "Kazlib"
+	KiCad_libraries_exception            ID = "KiCad-libraries-exception"
+	Knuth_CTAN                           ID = "Knuth-CTAN"
+	LAL_1_2                              ID = "LAL-1.2"
+	LAL_1_3                              ID = "LAL-1.3"
+	// Deprecated: ID is deprecated by SPDX.
+	LGPL_2_0 ID = "LGPL-2.0"
+	// Deprecated: ID is deprecated by SPDX.
+	LGPL_2_0_plus     ID = "LGPL-2.0+"
+	LGPL_2_0_only     ID = "LGPL-2.0-only"
+	LGPL_2_0_or_later ID = "LGPL-2.0-or-later"
+	// Deprecated: ID is deprecated by SPDX.
+	LGPL_2_1 ID = "LGPL-2.1"
+	// Deprecated: ID is deprecated by SPDX.
+	LGPL_2_1_plus     ID = "LGPL-2.1+"
+	LGPL_2_1_only     ID = "LGPL-2.1-only"
+	LGPL_2_1_or_later ID = "LGPL-2.1-or-later"
+	// Deprecated: ID is deprecated by SPDX.
+	LGPL_3_0 ID = "LGPL-3.0"
+	// Deprecated: ID is deprecated by SPDX.
+	LGPL_3_0_plus                     ID = "LGPL-3.0+"
+	LGPL_3_0_linking_exception        ID = "LGPL-3.0-linking-exception"
+	LGPL_3_0_only                     ID = "LGPL-3.0-only"
+	LGPL_3_0_or_later                 ID = "LGPL-3.0-or-later"
+	LGPLLR                            ID = "LGPLLR"
+	LLGPL                             ID = "LLGPL"
+	LLVM_exception                    ID = "LLVM-exception"
+	LOOP                              ID = "LOOP"
+	LPD_document                      ID = "LPD-document"
+	LPL_1_0                           ID = "LPL-1.0"
+	LPL_1_02                          ID = "LPL-1.02"
+	LPPL_1_0                          ID = "LPPL-1.0"
+	LPPL_1_1                          ID = "LPPL-1.1"
+	LPPL_1_2                          ID = "LPPL-1.2"
+	LPPL_1_3a                         ID = "LPPL-1.3a"
+	LPPL_1_3c                         ID = "LPPL-1.3c"
+	LZMA_SDK_9_11_to_9_20             ID = "LZMA-SDK-9.11-to-9.20"
+	LZMA_SDK_9_22                     ID = "LZMA-SDK-9.22"
+	LZMA_exception                    ID = "LZMA-exception"
+	Latex2e                           ID = "Latex2e"
+	Latex2e_translated_notice         ID = "Latex2e-translated-notice"
+	Leptonica                         ID = "Leptonica"
+	LiLiQ_P_1_1                       ID = "LiLiQ-P-1.1"
+	LiLiQ_R_1_1                       ID = "LiLiQ-R-1.1"
+	LiLiQ_Rplus_1_1                   ID = "LiLiQ-Rplus-1.1"
+	Libpng                            ID = "Libpng"
+	Libtool_exception                 ID = "Libtool-exception"
+	Linux_OpenIB                      ID = "Linux-OpenIB"
+	Linux_man_pages_1_para            ID = "Linux-man-pages-1-para"
+	Linux_man_pages_copyleft          ID = "Linux-man-pages-copyleft"
+	Linux_man_pages_copyleft_2_para   ID = "Linux-man-pages-copyleft-2-para"
+	Linux_man_pages_copyleft_var      ID = "Linux-man-pages-copyleft-var"
+	Linux_syscall_note                ID = "Linux-syscall-note"
+	Lucida_Bitmap_Fonts               ID = "Lucida-Bitmap-Fonts"
+	MIPS                              ID = "MIPS"
+	MIT                               ID = "MIT"
+	MIT_0                             ID = "MIT-0"
+	MIT_CMU                           ID = "MIT-CMU"
+	MIT_Click                         ID = "MIT-Click"
+	MIT_Festival                      ID = "MIT-Festival"
+	MIT_Khronos_old                   ID = "MIT-Khronos-old"
+	MIT_Modern_Variant                ID = "MIT-Modern-Variant"
+	MIT_Wu                            ID = "MIT-Wu"
+	MIT_advertising                   ID = "MIT-advertising"
+	MIT_enna                          ID = "MIT-enna"
+	MIT_feh                           ID = "MIT-feh"
+	MIT_open_group                    ID = "MIT-open-group"
+	MIT_testregex                     ID = "MIT-testregex"
+	MITNFA                            ID = "MITNFA"
+	MMIXware                          ID = "MMIXware"
+	MPEG_SSG                          ID = "MPEG-SSG"
+	MPL_1_0                           ID = "MPL-1.0"
+	MPL_1_1                           ID = "MPL-1.1"
+	MPL_2_0                           ID = "MPL-2.0"
+	MPL_2_0_no_copyleft_exception     ID = "MPL-2.0-no-copyleft-exception"
+	MS_LPL                            ID = "MS-LPL"
+	MS_PL                             ID = "MS-PL"
+	MS_RL                             ID = "MS-RL"
+	MTLL                              ID = "MTLL"
+	Mackerras_3_Clause                ID = "Mackerras-3-Clause"
+	Mackerras_3_Clause_acknowledgment ID = "Mackerras-3-Clause-acknowledgment"
+	MakeIndex                         ID = "MakeIndex"
+	Martin_Birgmeier                  ID = "Martin-Birgmeier"
+	McPhee_slideshow                  ID = "McPhee-slideshow"
+	Minpack                           ID = "Minpack"
+	MirOS                             ID = "MirOS"
+	Motosoto                          ID = "Motosoto"
+	MulanPSL_1_0                      ID = "MulanPSL-1.0"
+	MulanPSL_2_0                      ID = "MulanPSL-2.0"
+	Multics                           ID = "Multics"
+	Mup                               ID = "Mup"
+	NAIST_2003                        ID = "NAIST-2003"
+	NASA_1_3                          ID = "NASA-1.3"
+	NBPL_1_0                          ID = "NBPL-1.0"
+	NCBI_PD                           ID = "NCBI-PD"
+	NCGL_UK_2_0                       ID = "NCGL-UK-2.0"
+	NCL                               ID = "NCL"
+	NCSA                              ID = "NCSA"
+	NGPL                              ID = "NGPL"
+	NICTA_1_0                         ID = "NICTA-1.0"
+	NIST_PD                           ID = "NIST-PD"
+	NIST_PD_fallback                  ID = "NIST-PD-fallback"
+	NIST_Software                     ID = "NIST-Software"
+	NLOD_1_0                          ID = "NLOD-1.0"
+	NLOD_2_0                          ID = "NLOD-2.0"
+	NLPL                              ID = "NLPL"
+	NOSL                              ID = "NOSL"
+	NPL_1_0                           ID = "NPL-1.0"
+	NPL_1_1                           ID = "NPL-1.1"
+	NPOSL_3_0                         ID = "NPOSL-3.0"
+	NRL                               ID = "NRL"
+	NTIA_PD                           ID = "NTIA-PD"
+	NTP                               ID = "NTP"
+	NTP_0                             ID = "NTP-0"
+	Naumen                            ID = "Naumen"
+	// Deprecated: ID is deprecated by SPDX.
+	Net_SNMP               ID = "Net-SNMP"
+	NetCDF                 ID = "NetCDF"
+	Newsletr               ID = "Newsletr"
+	Nokia                  ID = "Nokia"
+	Nokia_Qt_exception_1_1 ID = "Nokia-Qt-exception-1.1"
+	Noweb                  ID = "Noweb"
+	// Deprecated: ID is deprecated by SPDX.
+	Nunit                             ID = "Nunit"
+	O_UDA_1_0                         ID = "O-UDA-1.0"
+	OAR                               ID = "OAR"
+	OCCT_PL                           ID = "OCCT-PL"
+	OCCT_exception_1_0                ID = "OCCT-exception-1.0"
+	OCLC_2_0                          ID = "OCLC-2.0"
+	OCaml_LGPL_linking_exception      ID = "OCaml-LGPL-linking-exception"
+	ODC_By_1_0                        ID = "ODC-By-1.0"
+	ODbL_1_0                          ID = "ODbL-1.0"
+	OFFIS                             ID = "OFFIS"
+	OFL_1_0                           ID = "OFL-1.0"
+	OFL_1_0_RFN                       ID = "OFL-1.0-RFN"
+	OFL_1_0_no_RFN                    ID = "OFL-1.0-no-RFN"
+	OFL_1_1                           ID = "OFL-1.1"
+	OFL_1_1_RFN                       ID = "OFL-1.1-RFN"
+	OFL_1_1_no_RFN                    ID = "OFL-1.1-no-RFN"
+	OGC_1_0                           ID = "OGC-1.0"
+	OGDL_Taiwan_1_0                   ID = "OGDL-Taiwan-1.0"
+	OGL_Canada_2_0                    ID = "OGL-Canada-2.0"
+	OGL_UK_1_0                        ID = "OGL-UK-1.0"
+	OGL_UK_2_0                        ID = "OGL-UK-2.0"
+	OGL_UK_3_0                        ID = "OGL-UK-3.0"
+	OGTSL                             ID = "OGTSL"
+	OLDAP_1_1                         ID = "OLDAP-1.1"
+	OLDAP_1_2                         ID = "OLDAP-1.2"
+	OLDAP_1_3                         ID = "OLDAP-1.3"
+	OLDAP_1_4                         ID = "OLDAP-1.4"
+	OLDAP_2_0                         ID = "OLDAP-2.0"
+	OLDAP_2_0_1                       ID = "OLDAP-2.0.1"
+	OLDAP_2_1                         ID = "OLDAP-2.1"
+	OLDAP_2_2                         ID = "OLDAP-2.2"
+	OLDAP_2_2_1                       ID = "OLDAP-2.2.1"
+	OLDAP_2_2_2                       ID = "OLDAP-2.2.2"
+	OLDAP_2_3                         ID = "OLDAP-2.3"
+	OLDAP_2_4                         ID = "OLDAP-2.4"
+	OLDAP_2_5                         ID = "OLDAP-2.5"
+	OLDAP_2_6                         ID = "OLDAP-2.6"
+	OLDAP_2_7                         ID = "OLDAP-2.7"
+	OLDAP_2_8                         ID = "OLDAP-2.8"
+	OLFL_1_3                          ID = "OLFL-1.3"
+	OML                               ID = "OML"
+	OPL_1_0                           ID = "OPL-1.0"
+	OPL_UK_3_0                        ID = "OPL-UK-3.0"
+	OPUBL_1_0                         ID = "OPUBL-1.0"
+	OSET_PL_2_1                       ID = "OSET-PL-2.1"
+	OSL_1_0                           ID = "OSL-1.0"
+	OSL_1_1                           ID = "OSL-1.1"
+	OSL_2_0                           ID = "OSL-2.0"
+	OSL_2_1                           ID = "OSL-2.1"
+	OSL_3_0                           ID = "OSL-3.0"
+	OpenJDK_assembly_exception_1_0    ID = "OpenJDK-assembly-exception-1.0"
+	OpenPBS_2_3                       ID = "OpenPBS-2.3"
+	OpenSSL                           ID = "OpenSSL"
+	OpenSSL_standalone                ID = "OpenSSL-standalone"
+	OpenVision                        ID = "OpenVision"
+	PADL                              ID = "PADL"
+	PCRE2_exception                   ID = "PCRE2-exception"
+	PDDL_1_0                          ID = "PDDL-1.0"
+	PHP_3_0                           ID = "PHP-3.0"
+	PHP_3_01                          ID = "PHP-3.01"
+	PPL                               ID = "PPL"
+	PS_or_PDF_font_exception_20170817 ID = "PS-or-PDF-font-exception-20170817"
+	PSF_2_0                           ID = "PSF-2.0"
+	Parity_6_0_0                      ID = "Parity-6.0.0"
+	Parity_7_0_0                      ID = "Parity-7.0.0"
+	Pixar                             ID = "Pixar"
+	Plexus                            ID = "Plexus"
+	PolyForm_Noncommercial_1_0_0      ID = "PolyForm-Noncommercial-1.0.0"
+	PolyForm_Small_Business_1_0_0     ID = "PolyForm-Small-Business-1.0.0"
+	PostgreSQL                        ID = "PostgreSQL"
+	Python_2_0                        ID = "Python-2.0"
+	Python_2_0_1                      ID = "Python-2.0.1"
+	QPL_1_0                           ID = "QPL-1.0"
+	QPL_1_0_INRIA_2004                ID = "QPL-1.0-INRIA-2004"
+	QPL_1_0_INRIA_2004_exception      ID = "QPL-1.0-INRIA-2004-exception"
+	Qhull                             ID = "Qhull"
+	Qt_GPL_exception_1_0              ID = "Qt-GPL-exception-1.0"
+	Qt_LGPL_exception_1_1             ID = "Qt-LGPL-exception-1.1"
+	Qwt_exception_1_0                 ID = "Qwt-exception-1.0"
+	RHeCos_1_1                        ID = "RHeCos-1.1"
+	RPL_1_1                           ID = "RPL-1.1"
+	RPL_1_5                           ID = "RPL-1.5"
+	RPSL_1_0                          ID = "RPSL-1.0"
+	RRDtool_FLOSS_exception_2_0       ID = "RRDtool-FLOSS-exception-2.0"
+	RSA_MD                            ID = "RSA-MD"
+	RSCPL                             ID = "RSCPL"
+	Rdisc                             ID = "Rdisc"
+	Ruby                              ID = "Ruby"
+	Ruby_pty                          ID = "Ruby-pty"
+	SANE_exception                    ID = "SANE-exception"
+	SAX_PD                            ID = "SAX-PD"
+	SAX_PD_2_0                        ID = "SAX-PD-2.0"
+	SCEA                              ID = "SCEA"
+	SGI_B_1_0                         ID = "SGI-B-1.0"
+	SGI_B_1_1                         ID = "SGI-B-1.1"
+	SGI_B_2_0                         ID = "SGI-B-2.0"
+	SGI_OpenGL                        ID = "SGI-OpenGL"
+	SGP4                              ID = "SGP4"
+	SHL_0_5                           ID = "SHL-0.5"
+	SHL_0_51                          ID = "SHL-0.51"
+	SHL_2_0                           ID = "SHL-2.0"
+	SHL_2_1                           ID = "SHL-2.1"
+	SISSL                             ID = "SISSL"
+	SISSL_1_2                         ID = "SISSL-1.2"
+	SL                                ID = "SL"
+	SMAIL_GPL                         ID = "SMAIL-GPL"
+	SMLNJ                             ID = "SMLNJ"
+	SMPPL                             ID = "SMPPL"
+	SNIA                              ID = "SNIA"
+	SOFA                              ID = "SOFA"
+	SPL_1_0                           ID = "SPL-1.0"
+	SSH_OpenSSH                       ID = "SSH-OpenSSH"
+	SSH_short                         ID = "SSH-short"
+	SSLeay_standalone                 ID = "SSLeay-standalone"
+	SSPL_1_0                          ID = "SSPL-1.0"
+	SUL_1_0                           ID = "SUL-1.0"
+	SWI_exception                     ID = "SWI-exception"
+	SWL                               ID = "SWL"
+	Saxpath                           ID = "Saxpath"
+	SchemeReport                      ID = "SchemeReport"
+	Sendmail                          ID = "Sendmail"
+	Sendmail_8_23                     ID = "Sendmail-8.23"
+	Sendmail_Open_Source_1_1          ID = "Sendmail-Open-Source-1.1"
+	SimPL_2_0                         ID = "SimPL-2.0"
+	Sleepycat                         ID = "Sleepycat"
+	Soundex                           ID = "Soundex"
+	Spencer_86                        ID = "Spencer-86"
+	Spencer_94                        ID = "Spencer-94"
+	Spencer_99                        ID = "Spencer-99"
+	// Deprecated: ID is deprecated by SPDX.
+	StandardML_NJ                        ID = "StandardML-NJ"
+	SugarCRM_1_1_3                       ID = "SugarCRM-1.1.3"
+	Sun_PPP                              ID = "Sun-PPP"
+	Sun_PPP_2000                         ID = "Sun-PPP-2000"
+	SunPro                               ID = "SunPro"
+	Swift_exception                      ID = "Swift-exception"
+	Symlinks                             ID = "Symlinks"
+	TAPR_OHL_1_0                         ID = "TAPR-OHL-1.0"
+	TCL                                  ID = "TCL"
+	TCP_wrappers                         ID = "TCP-wrappers"
+	TGPPL_1_0                            ID = "TGPPL-1.0"
+	TMate                                ID = "TMate"
+	TORQUE_1_1                           ID = "TORQUE-1.1"
+	TOSL                                 ID = "TOSL"
+	TPDL                                 ID = "TPDL"
+	TPL_1_0                              ID = "TPL-1.0"
+	TTWL                                 ID = "TTWL"
+	TTYP0                                ID = "TTYP0"
+	TU_Berlin_1_0                        ID = "TU-Berlin-1.0"
+	TU_Berlin_2_0                        ID = "TU-Berlin-2.0"
+	TermReadKey                          ID = "TermReadKey"
+	Texinfo_exception                    ID = "Texinfo-exception"
+	ThirdEye                             ID = "ThirdEye"
+	TrustedQSL                           ID = "TrustedQSL"
+	UBDL_exception                       ID = "UBDL-exception"
+	UCAR                                 ID = "UCAR"
+	UCL_1_0                              ID = "UCL-1.0"
+	UMich_Merit                          ID = "UMich-Merit"
+	UPL_1_0                              ID = "UPL-1.0"
+	URT_RLE                              ID = "URT-RLE"
+	Ubuntu_font_1_0                      ID = "Ubuntu-font-1.0"
+	Unicode_3_0                          ID = "Unicode-3.0"
+	Unicode_DFS_2015                     ID = "Unicode-DFS-2015"
+	Unicode_DFS_2016                     ID = "Unicode-DFS-2016"
+	Unicode_TOU                          ID = "Unicode-TOU"
+	Universal_FOSS_exception_1_0         ID = "Universal-FOSS-exception-1.0"
+	UnixCrypt                            ID = "UnixCrypt"
+	Unlicense                            ID = "Unlicense"
+	Unlicense_libtelnet                  ID = "Unlicense-libtelnet"
+	Unlicense_libwhirlpool               ID = "Unlicense-libwhirlpool"
+	VOSTROM                              ID = "VOSTROM"
+	VSL_1_0                              ID = "VSL-1.0"
+	Vim                                  ID = "Vim"
+	W3C                                  ID = "W3C"
+	W3C_19980720                         ID = "W3C-19980720"
+	W3C_20150513                         ID = "W3C-20150513"
+	WTFPL                                ID = "WTFPL"
+	Watcom_1_0                           ID = "Watcom-1.0"
+	Widget_Workshop                      ID = "Widget-Workshop"
+	Wsuipa                               ID = "Wsuipa"
+	WxWindows_exception_3_1              ID = "WxWindows-exception-3.1"
+	X11                                  ID = "X11"
+	X11_distribute_modifications_variant ID = "X11-distribute-modifications-variant"
+	X11_swapped                          ID = "X11-swapped"
+	XFree86_1_1                          ID = "XFree86-1.1"
+	XSkat                                ID = "XSkat"
+	Xdebug_1_03                          ID = "Xdebug-1.03"
+	Xerox                                ID = "Xerox"
+	Xfig                                 ID = "Xfig"
+	Xnet                                 ID = "Xnet"
+	YPL_1_0                              ID = "YPL-1.0"
+	YPL_1_1                              ID = "YPL-1.1"
+	ZPL_1_1                              ID = "ZPL-1.1"
+	ZPL_2_0                              ID = "ZPL-2.0"
+	ZPL_2_1                              ID = "ZPL-2.1"
+	Zed                                  ID = "Zed"
+	Zeeff                                ID = "Zeeff"
+	Zend_2_0                             ID = "Zend-2.0"
+	Zimbra_1_3                           ID = "Zimbra-1.3"
+	Zimbra_1_4                           ID = "Zimbra-1.4"
+	Zlib                                 ID = "Zlib"
+	Any_OSI                              ID = "any-OSI"
+	Any_OSI_perl_modules                 ID = "any-OSI-perl-modules"
+	Bcrypt_Solar_Designer                ID = "bcrypt-Solar-Designer"
+	Blessing                             ID = "blessing"
+	// Deprecated: ID is deprecated by SPDX.
+	Bzip2_1_0_5                  ID = "bzip2-1.0.5"
+	Bzip2_1_0_6                  ID = "bzip2-1.0.6"
+	Check_cvs                    ID = "check-cvs"
+	Checkmk                      ID = "checkmk"
+	Copyleft_next_0_3_0          ID = "copyleft-next-0.3.0"
+	Copyleft_next_0_3_1          ID = "copyleft-next-0.3.1"
+	Cryptsetup_OpenSSL_exception ID = "cryptsetup-OpenSSL-exception"
+	Curl                         ID = "curl"
+	Cve_tou                      ID = "cve-tou"
+	Diffmark                     ID = "diffmark"
+	Dtoa                         ID = "dtoa"
+	Dvipdfm                      ID = "dvipdfm"
+	// Deprecated: ID is deprecated by SPDX.
+	ECos_2_0                     ID = "eCos-2.0"
+	ECos_exception_2_0           ID = "eCos-exception-2.0"
+	EGenix                       ID = "eGenix"
+	Erlang_otp_linking_exception ID = "erlang-otp-linking-exception"
+	Etalab_2_0                   ID = "etalab-2.0"
+	Fmt_exception                ID = "fmt-exception"
+	Freertos_exception_2_0       ID = "freertos-exception-2.0"
+	Fwlw                         ID = "fwlw"
+	GSOAP_1_3b                   ID = "gSOAP-1.3b"
+	Generic_xts                  ID = "generic-xts"
+	Gnu_javamail_exception       ID = "gnu-javamail-exception"
+	Gnuplot                      ID = "gnuplot"
+	Gtkbook                      ID = "gtkbook"
+	Harbour_exception            ID = "harbour-exception"
+	Hdparm                       ID = "hdparm"
+	I2p_gpl_java_exception       ID = "i2p-gpl-java-exception"
+	IMatix                       ID = "iMatix"
+	Jove                         ID = "jove"
+	Libpng_1_6_35                ID = "libpng-1.6.35"
+	Libpng_2_0                   ID = "libpng-2.0"
+	Libpri_OpenH323_exception    ID = "libpri-OpenH323-exception"
+	Libselinux_1_0               ID = "libselinux-1.0"
+	Libtiff                      ID = "libtiff"
+	Libutil_David_Nugent         ID = "libutil-David-Nugent"
+	Lsof                         ID = "lsof"
+	Magaz                        ID = "magaz"
+	Mailprio                     ID = "mailprio"
+	Man2html                     ID = "man2html"
+	Metamail                     ID = "metamail"
+	Mif_exception                ID = "mif-exception"
+	Mpi_permissive               ID = "mpi-permissive"
+	Mpich2                       ID = "mpich2"
+	Mplus                        ID = "mplus"
+	Mxml_exception               ID = "mxml-exception"
+	Ngrep                        ID = "ngrep"
+	Openvpn_openssl_exception    ID = "openvpn-openssl-exception"
+	Pkgconf                      ID = "pkgconf"
+	Pnmstitch                    ID = "pnmstitch"
+	Polyparse_exception          ID = "polyparse-exception"
+	Psfrag                       ID = "psfrag"
+	Psutils                      ID = "psutils"
+	Python_ldap                  ID = "python-ldap"
+	Radvd                        ID = "radvd"
+	Romic_exception              ID = "romic-exception"
+	Snprintf                     ID = "snprintf"
+	SoftSurfer                   ID = "softSurfer"
+	Ssh_keyscan                  ID = "ssh-keyscan"
+	Stunnel_exception            ID = "stunnel-exception"
+	Swrule                       ID = "swrule"
+	Threeparttable               ID = "threeparttable"
+	U_boot_exception_2_0         ID = "u-boot-exception-2.0"
+	Ulem                         ID = "ulem"
+	Vsftpd_openssl_exception     ID = "vsftpd-openssl-exception"
+	W3m                          ID = "w3m"
+	Wwl                          ID = "wwl"
+	// Deprecated: ID is deprecated by SPDX.
+	WxWindows                 ID = "wxWindows"
+	X11vnc_openssl_exception  ID = "x11vnc-openssl-exception"
+	Xinetd                    ID = "xinetd"
+	Xkeyboard_config_Zinoviev ID = "xkeyboard-config-Zinoviev"
+	Xlock                     ID = "xlock"
+	Xpp                       ID = "xpp"
+	Xzoom                     ID = "xzoom"
+	Zlib_acknowledgement      ID = "zlib-acknowledgement"
+)
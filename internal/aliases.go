@@ -0,0 +1,280 @@
+package internal
+
+import (
+	"slices"
+	"strings"
+)
+
+// Alternative spelling of SPDX ID or ambiguous short form
+type Alias struct {
+	// Lowercase spelling, words are separated by single spaces
+	From string
+	To   string
+	// Lossy aliases guess details the spelling doesn't carry,
+	// like version of "Apache" or clause count of "BSD"
+	Lossy bool
+}
+
+// Built-in real-world license spellings.
+// Bare GPL family versions map to ambiguous short forms, that is exact.
+// "X11" is an SPDX ID on its own and "Public Domain" has no SPDX ID
+// (LicenseRef- should be used), so neither is listed.
+var Aliases = []Alias{
+	// GPL
+	{"gpl3", "GPL-3.0", false},
+	{"gpl-3", "GPL-3.0", false},
+	{"gpl2", "GPL-2.0", false},
+	{"gpl-2", "GPL-2.0", false},
+	{"gplv1", "GPL-1.0", false},
+	{"gplv2", "GPL-2.0", false},
+	{"gplv3", "GPL-3.0", false},
+	{"gplv2+", "GPL-2.0-or-later", false},
+	{"gplv3+", "GPL-3.0-or-later", false},
+	{"gpl v2", "GPL-2.0", false},
+	{"gpl v3", "GPL-3.0", false},
+	{"gpl v2+", "GPL-2.0-or-later", false},
+	{"gpl v3+", "GPL-3.0-or-later", false},
+	{"gpl 2", "GPL-2.0", false},
+	{"gpl 3", "GPL-3.0", false},
+	{"gplv2 or later", "GPL-2.0-or-later", false},
+	{"gplv3 or later", "GPL-3.0-or-later", false},
+	{"gpl-2.0 or later", "GPL-2.0-or-later", false},
+	{"gpl-3.0 or later", "GPL-3.0-or-later", false},
+	{"gnu gpl", "GPL", false},
+	{"gnu gplv2", "GPL-2.0", false},
+	{"gnu gplv3", "GPL-3.0", false},
+	{"gnu gpl v2", "GPL-2.0", false},
+	{"gnu gpl v3", "GPL-3.0", false},
+	// LGPL
+	{"lgplv2", "LGPL-2.0", false},
+	{"lgplv2+", "LGPL-2.0-or-later", false},
+	{"lgpl2.1", "LGPL-2.1", false},
+	{"lgpl2.1+", "LGPL-2.1-or-later", false},
+	{"lgpl21", "LGPL-2.1", false},
+	{"lgplv2.1", "LGPL-2.1", false},
+	{"lgplv2.1+", "LGPL-2.1-or-later", false},
+	{"lgplv3", "LGPL-3.0", false},
+	{"lgplv3+", "LGPL-3.0-or-later", false},
+	{"lgpl v2.1", "LGPL-2.1", false},
+	{"lgpl v3", "LGPL-3.0", false},
+	{"lgpl 2.1", "LGPL-2.1", false},
+	{"lgpl 3", "LGPL-3.0", false},
+	{"lgplv2.1 or later", "LGPL-2.1-or-later", false},
+	{"lgpl-2.1 or later", "LGPL-2.1-or-later", false},
+	{"gnu lgpl", "LGPL", false},
+	// AGPL
+	{"agplv3", "AGPL-3.0", false},
+	{"agplv3+", "AGPL-3.0-or-later", false},
+	{"agpl v3", "AGPL-3.0", false},
+	{"agpl 3", "AGPL-3.0", false},
+	// GFDL
+	{"fdl-1.1", "GFDL-1.1", false},
+	{"fdl-1.2", "GFDL-1.2", false},
+	{"fdl-1.3", "GFDL-1.3", false},
+	{"gfdl", "GFDL-1.3", true},
+	// Apache
+	{"apache 2", "Apache-2.0", false},
+	{"apache 2.0", "Apache-2.0", false},
+	{"apache v2", "Apache-2.0", false},
+	{"apache license 2", "Apache-2.0", false},
+	{"apache license 2.0", "Apache-2.0", false},
+	{"apache license v2", "Apache-2.0", false},
+	{"apache license v2.0", "Apache-2.0", false},
+	{"apache software license 2.0", "Apache-2.0", false},
+	{"apache 1.1", "Apache-1.1", false},
+	{"asl2", "Apache-2.0", false},
+	{"asl20", "Apache-2.0", false},
+	{"asl-2.0", "Apache-2.0", false},
+	{"asl 2", "Apache-2.0", false},
+	{"asl 2.0", "Apache-2.0", false},
+	{"asl v2", "Apache-2.0", false},
+	{"asl11", "Apache-1.1", false},
+	{"asl 1.1", "Apache-1.1", false},
+	{"apache", "Apache-2.0", true},
+	{"asl", "Apache-2.0", true},
+	// BSD
+	{"bsd-new", "BSD-3-Clause", false},
+	{"bsd new", "BSD-3-Clause", false},
+	{"new bsd", "BSD-3-Clause", false},
+	{"newbsd", "BSD-3-Clause", false},
+	{"modified bsd", "BSD-3-Clause", false},
+	{"revised bsd", "BSD-3-Clause", false},
+	{"bsd 3-clause", "BSD-3-Clause", false},
+	{"3-clause bsd", "BSD-3-Clause", false},
+	{"simplified bsd", "BSD-2-Clause", false},
+	{"bsd-simplified", "BSD-2-Clause", false},
+	{"freebsd", "BSD-2-Clause", true},
+	{"bsd 2-clause", "BSD-2-Clause", false},
+	{"2-clause bsd", "BSD-2-Clause", false},
+	{"original bsd", "BSD-4-Clause", false},
+	{"bsd-original", "BSD-4-Clause", false},
+	{"bsdoriginal", "BSD-4-Clause", false},
+	{"bsd 4-clause", "BSD-4-Clause", false},
+	{"4-clause bsd", "BSD-4-Clause", false},
+	{"bsd0", "0BSD", false},
+	{"zero-clause bsd", "0BSD", false},
+	{"bsd zero clause", "0BSD", false},
+	{"bsd", "BSD-3-Clause", true},
+	{"bsd-3", "BSD-3-Clause", true},
+	{"bsd3", "BSD-3-Clause", true},
+	{"bsd-2", "BSD-2-Clause", true},
+	{"bsd2", "BSD-2-Clause", true},
+	{"bsd-4", "BSD-4-Clause", true},
+	{"bsd4", "BSD-4-Clause", true},
+	{"bsd-style", "BSD-3-Clause", true},
+	// MIT
+	{"expat", "MIT", false},
+	{"mit license", "MIT", false},
+	{"expat license", "MIT", false},
+	{"mit/expat", "MIT", false},
+	{"mit/x11", "MIT", true},
+	{"mit-style", "MIT", true},
+	// MPL
+	{"mplv2", "MPL-2.0", false},
+	{"mpl20", "MPL-2.0", false},
+	{"mpl 2", "MPL-2.0", false},
+	{"mpl 2.0", "MPL-2.0", false},
+	{"mpl v2", "MPL-2.0", false},
+	{"mozilla public license 2.0", "MPL-2.0", false},
+	{"mpl11", "MPL-1.1", false},
+	{"mpl 1.1", "MPL-1.1", false},
+	{"mpl", "MPL-2.0", true},
+	// Eclipse
+	{"epl10", "EPL-1.0", false},
+	{"epl20", "EPL-2.0", false},
+	{"epl 1.0", "EPL-1.0", false},
+	{"epl 2.0", "EPL-2.0", false},
+	{"eclipse public license 2.0", "EPL-2.0", false},
+	{"epl", "EPL-2.0", true},
+	// EUPL
+	{"eupl 1.2", "EUPL-1.2", false},
+	{"eupl v1.2", "EUPL-1.2", false},
+	{"eupl", "EUPL-1.2", true},
+	// CDDL
+	{"cddl 1.0", "CDDL-1.0", false},
+	{"cddl 1.1", "CDDL-1.1", false},
+	{"cddl", "CDDL-1.0", true},
+	// Artistic
+	{"artistic2", "Artistic-2.0", false},
+	{"artistic 2.0", "Artistic-2.0", false},
+	{"artistic", "Artistic-1.0-Perl", true},
+	// Python
+	{"psf", "PSF-2.0", true},
+	{"psfl", "PSF-2.0", true},
+	{"python software foundation license", "PSF-2.0", true},
+	{"python", "Python-2.0", true},
+	// Zlib
+	{"zlib/libpng", "Zlib", false},
+	{"zlib-libpng", "Zlib", false},
+	{"zlib license", "Zlib", false},
+	// Creative Commons
+	{"cc0", "CC0-1.0", false},
+	{"cc-zero", "CC0-1.0", false},
+	{"cc zero", "CC0-1.0", false},
+	{"creative commons zero", "CC0-1.0", false},
+	// Others
+	{"boost", "BSL-1.0", false},
+	{"boost 1.0", "BSL-1.0", false},
+	{"boost software license", "BSL-1.0", false},
+	{"boost software license 1.0", "BSL-1.0", false},
+	{"elv2", "Elastic-2.0", false},
+	{"elastic license 2.0", "Elastic-2.0", false},
+	{"elastic", "Elastic-2.0", true},
+	{"busl", "BUSL-1.1", true},
+	{"isc license", "ISC", false},
+	{"uoi-ncsa", "NCSA", false},
+	{"ofl 1.1", "OFL-1.1", false},
+	{"sil ofl 1.1", "OFL-1.1", false},
+	{"sil open font license 1.1", "OFL-1.1", false},
+	{"ofl", "OFL-1.1", true},
+	{"php", "PHP-3.01", true},
+	{"sspl", "SSPL-1.0", false},
+	{"the unlicense", "Unlicense", false},
+	{"wtfpl-2", "WTFPL", false},
+	{"zope", "ZPL-2.1", true},
+	{"zpl", "ZPL-2.1", true},
+}
+
+func (db *DB) addAlias(alias Alias) {
+	switch {
+	case strings.Contains(alias.From, " "):
+		db.phraseAliases = append(db.phraseAliases, alias)
+	case alias.Lossy:
+		db.lossyAliases[alias.From] = alias.To
+	default:
+		db.Canonical[alias.From] = alias.To
+	}
+}
+
+func (db *DB) sortPhrases() {
+	slices.SortStableFunc(db.phraseAliases, func(a, b Alias) int {
+		return strings.Count(b.From, " ") - strings.Count(a.From, " ")
+	})
+}
+
+func (db *DB) initAliases() {
+	db.aliases = slices.Clone(Aliases)
+	db.lossyAliases = make(map[string]string)
+	db.phraseAliases = make([]Alias, 0)
+	for _, alias := range db.aliases {
+		db.addAlias(alias)
+	}
+	db.sortPhrases()
+}
+
+// hasAlias reports if spelling (normalised as Alias.From) is already
+// registered as alias. Caller must hold aliasesMu.
+func (db *DB) hasAlias(from string) bool {
+	if _, ok := db.lossyAliases[from]; ok {
+		return true
+	}
+	return slices.ContainsFunc(db.phraseAliases, func(a Alias) bool {
+		return a.From == from
+	})
+}
+
+// mergePhrases replaces sequences of tokens matching multi-word aliases
+// with their targets. Lossy aliases are used only if lossy is set.
+func (db *DB) mergePhrases(tokens []string, lossy bool) []string {
+	db.aliasesMu.RLock()
+	defer db.aliasesMu.RUnlock()
+	out := make([]string, 0, len(tokens))
+	for i := 0; i < len(tokens); {
+		matched := false
+		for _, alias := range db.phraseAliases {
+			if alias.Lossy && !lossy {
+				continue
+			}
+			words := strings.Split(alias.From, " ")
+			if i+len(words) > len(tokens) {
+				continue
+			}
+			if strings.ToLower(strings.Join(tokens[i:i+len(words)], " ")) != alias.From {
+				continue
+			}
+			out = append(out, alias.To)
+			i += len(words)
+			matched = true
+			break
+		}
+		if !matched {
+			out = append(out, tokens[i])
+			i++
+		}
+	}
+	return out
+}
+
+func (db *DB) lookupLossy(token string) (string, bool) {
+	db.aliasesMu.RLock()
+	defer db.aliasesMu.RUnlock()
+	to, ok := db.lossyAliases[token]
+	return to, ok
+}
+
+// ListAliases returns built-in and registered aliases.
+func (db *DB) ListAliases() []Alias {
+	db.aliasesMu.RLock()
+	defer db.aliasesMu.RUnlock()
+	return slices.Clone(db.aliases)
+}
@@ -0,0 +1,72 @@
+package internal_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+func Test_Aliases(t *testing.T) {
+	db := internal.Default()
+	seen := make(map[string]bool)
+	for _, alias := range internal.Aliases {
+		t.Run(alias.From, func(t *testing.T) {
+			_, isFile := db.Files[alias.To]
+			_, isGlob := db.Globs[alias.To]
+			if !isFile && !isGlob {
+				t.Fatalf("alias %v points to unknown ID %v", alias.From, alias.To)
+			}
+			if alias.From != strings.Join(strings.Fields(strings.ToLower(alias.From)), " ") {
+				t.Fatalf("alias %v is not normalised", alias.From)
+			}
+			if seen[alias.From] {
+				t.Fatalf("alias %v is duplicated", alias.From)
+			}
+			seen[alias.From] = true
+
+			want := []string{alias.To}
+			lossy := &internal.Profile{Lossy: true}
+			if got := db.TokeniseWith(alias.From, lossy); !reflect.DeepEqual(got, want) {
+				t.Fatalf("TokeniseWith(%v, lossy) = %v; want %v", alias.From, got, want)
+			}
+			got := db.TokeniseWith(alias.From, nil)
+			if alias.Lossy && reflect.DeepEqual(got, want) {
+				t.Fatalf("Tokenise(%v) = %v; lossy alias must not apply", alias.From, got)
+			}
+			if !alias.Lossy && !reflect.DeepEqual(got, want) {
+				t.Fatalf("Tokenise(%v) = %v; want %v", alias.From, got, want)
+			}
+		})
+	}
+}
+
+func Test_TokeniseWithLossy(t *testing.T) {
+	tests := []struct {
+		in    string
+		lossy bool
+		want  []string
+	}{
+		{"Apache 2 OR New BSD", false, []string{"Apache-2.0", "OR", "BSD-3-Clause"}},
+		{"LGPLv2.1+ and zlib/libpng", false, []string{"LGPL-2.1-or-later", "AND", "Zlib"}},
+		{"GPLv3 or later", false, []string{"GPL-3.0-or-later"}},
+		{"Expat X11", false, []string{"MIT", "X11"}},
+		{"BSD or Apache", false, []string{"BSD", "OR", "apache"}},
+		{"BSD or Apache", true, []string{"BSD-3-Clause", "OR", "Apache-2.0"}},
+		{"PSF+", true, []string{"PSF-2.0+"}},
+		{"Public Domain", true, []string{"public", "domain"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			p := &internal.Profile{Lossy: tc.lossy}
+			got := internal.Default().TokeniseWith(tc.in, p)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("TokeniseWith(%v, %v) = %v; want %v", tc.in, tc.lossy, got, tc.want)
+			}
+		})
+	}
+}
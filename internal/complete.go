@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// Most used IDs, most popular first
+var Popular = []string{
+	"MIT",
+	"Apache-2.0",
+	"BSD-3-Clause",
+	"GPL-3.0-or-later",
+	"GPL-3.0-only",
+	"GPL-2.0-or-later",
+	"GPL-2.0-only",
+	"BSD-2-Clause",
+	"ISC",
+	"MPL-2.0",
+	"LGPL-3.0-or-later",
+	"LGPL-3.0-only",
+	"LGPL-2.1-or-later",
+	"LGPL-2.1-only",
+	"AGPL-3.0-or-later",
+	"AGPL-3.0-only",
+	"Unlicense",
+	"CC0-1.0",
+	"0BSD",
+	"EPL-2.0",
+	"Zlib",
+	"CC-BY-4.0",
+	"CC-BY-SA-4.0",
+	"BSL-1.0",
+	"Artistic-2.0",
+	"MIT-0",
+	"EPL-1.0",
+	"WTFPL",
+	"Python-2.0",
+	"OFL-1.1",
+	"EUPL-1.2",
+	"CDDL-1.0",
+	"MPL-1.1",
+	"Apache-1.1",
+	"BlueOak-1.0.0",
+	"LLVM-exception",
+	"Classpath-exception-2.0",
+	"GCC-exception-3.1",
+}
+
+func (db *DB) insertCompletions(t *Trie, key string, ids ...string) {
+	if key == "" {
+		return
+	}
+	for _, id := range ids {
+		if strings.HasPrefix(id, "deprecated_") {
+			continue
+		}
+		if _, ok := db.Files[id]; !ok {
+			continue
+		}
+		t.Insert(key, id)
+	}
+}
+
+func (db *DB) buildCompletionTrie() *Trie {
+	t := &Trie{}
+	for _, file := range db.Filenames {
+		db.insertCompletions(t, strings.ToLower(file), file)
+	}
+	for glob, files := range db.Globs {
+		if strings.HasSuffix(glob, "-") || strings.HasSuffix(glob, ".") {
+			continue
+		}
+		db.insertCompletions(t, strings.ToLower(glob), files...)
+	}
+	db.aliasesMu.RLock()
+	defer db.aliasesMu.RUnlock()
+	for form, target := range db.Canonical {
+		if globs, ok := db.Globs[target]; ok {
+			db.insertCompletions(t, form, globs...)
+		}
+		db.insertCompletions(t, form, target)
+	}
+	return t
+}
+
+func (db *DB) resetCompletions() {
+	db.completeMu.Lock()
+	db.completeTrie = nil
+	db.completeMu.Unlock()
+}
+
+// Position of id in Popular or len(Popular) for less used IDs
+func popularity(id string) int {
+	if i := slices.Index(Popular, id); i >= 0 {
+		return i
+	}
+	return len(Popular)
+}
+
+func (db *DB) completions() *Trie {
+	db.completeMu.Lock()
+	defer db.completeMu.Unlock()
+	if db.completeTrie == nil {
+		db.completeTrie = db.buildCompletionTrie()
+	}
+	return db.completeTrie
+}
+
+// Complete returns up to limit IDs matching prefix, ranked by exactness of
+// match and popularity. Non-positive limit means no limit.
+func (db *DB) Complete(prefix string, limit int) []string {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	found := db.completions().WithPrefix(prefix)
+	ids := make([]string, 0, len(found))
+	for id := range found {
+		ids = append(ids, id)
+	}
+	exact := func(id string) int {
+		return min(found[id], 1)
+	}
+	slices.SortFunc(ids, func(a, b string) int {
+		return cmp.Or(
+			cmp.Compare(exact(a), exact(b)),
+			cmp.Compare(popularity(a), popularity(b)),
+			cmp.Compare(found[a], found[b]),
+			cmp.Compare(len(a), len(b)),
+			strings.Compare(a, b),
+		)
+	})
+	if limit > 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids
+}
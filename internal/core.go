@@ -1,28 +1,37 @@
 package internal
 
 import (
-	"archive/zip"
-	"bytes"
-	"io"
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
 	"slices"
 	"strconv"
 	"strings"
 )
 
-//go:generate go run genembed.go -url=https://github.com/spdx/license-list-data/archive/refs/tags/v3.27.0.zip -name=spdx3.27.0.zip
+// Only license list 3.27.0 is embedded. Other versions are added by
+// running genembed.go once per release with its -url, -sha256 and -name;
+// every archive in notext/ stays embedded and go generate keeps them.
+//
+//go:generate go run -tags licensedb_noembed genembed.go -url=https://github.com/spdx/license-list-data/archive/refs/tags/v3.27.0.zip -name=spdx3.27.0.zip
 
 var (
-	Files     map[string]*zip.File
-	Filenames []string
-	Globs     map[string][]string
-	Canonical map[string]string
+	ErrUnknownID      = errors.New("unknown SPDX ID")
+	ErrAliasExists    = errors.New("alias already exists")
+	ErrInvalidAlias   = errors.New("invalid alias")
+	ErrNoLicenses     = errors.New("no license texts found")
+	ErrUnknownVersion = errors.New("unknown license list version")
+	ErrNoText         = errors.New("license texts are not available")
+	ErrNoTemplate     = errors.New("no license template")
+	ErrNoHeader       = errors.New("no standard license header")
 )
 
 var (
 	Keywords = []string{"WITH", "AND", "OR"}
-	// Map of Deprecated IDs to expressions
-	// Result of mapping may be ambiguous
-	Deprecated = map[string][]string{
+	// Deprecated IDs that expand to expressions rather than to the
+	// same ID with "deprecated_" prefix
+	DeprecatedExpressions = map[string][]string{
 		"gpl-3.0+": {"gpl-3.0-or-later"},
 		"gpl-2.0+": {"gpl-2.0-or-later"},
 		"gpl-3.0-with-autoconf-exception": {
@@ -32,69 +41,26 @@ var (
 			"gpl-3.0-or-later", "with", "gcc-exception-3.1",
 		},
 	}
-	Aliases = []struct{ from, to string }{
-		{"gpl3", "GPL-3.0"},
-		{"gpl-3", "GPL-3.0"},
-		{"gpl2", "GPL-2.0"},
-		{"gpl-2", "GPL-2.0"},
-		// nixpkgs
-		{"asl20", "Apache-2.0"},
-		{"asl11", "Apache-1.1"},
-	}
-	// TODO:
-	ExceptionsList = []string{
-		"GNU-compiler-exception",
-		"GNOME-examples-exception",
-		"Autoconf-exception-generic",
-		"Autoconf-exception-generic-3.0",
-		"Autoconf-exception-macro",
-		"Autoconf-exception-2.0",
-		"Autoconf-exception-3.0",
-		"GCC-exception-2.0-note",
-		"GCC-exception-2.0",
-		"GCC-exception-3.1",
-	}
 )
 
-func GetText(name string) *string {
-	f, ok := Files[name]
-	if !ok {
-		return nil
-	}
-	rc, err := f.Open()
+// GetText returns text of license file name or nil if there is no such
+// file or it can't be read. Use ReadText to get the error.
+func (db *DB) GetText(name string) *string {
+	text, err := db.ReadText(name)
 	if err != nil {
-		// There should not be errors while working with embedded archive
-		panic(err)
-	}
-	data, err := io.ReadAll(rc)
-	rc.Close()
-	if err != nil {
-		// There should not be errors while working with embedded archive
-		panic(err)
+		return nil
 	}
-	strdata := string(data)
-	return &strdata
+	return &text
 }
 
-func List() []string {
-	out := make([]string, len(Filenames))
-	copy(out, Filenames)
-	return out
+func GetText(name string) *string {
+	return Default().GetText(name)
 }
 
-func initFiles() {
-	r := bytes.NewReader(archive)
-	zr, err := zip.NewReader(r, int64(len(archive)))
-	if err != nil {
-		// There should not be errors while working with embedded archive
-		panic(err)
-	}
-	Files = make(map[string]*zip.File, len(zr.File))
-	Filenames = make([]string, 0, len(zr.File))
-	for _, f := range zr.File {
-		Files[f.Name] = f
-		Filenames = append(Filenames, f.Name)
-	}
+func (db *DB) List() []string {
+	out := make([]string, len(db.Filenames))
+	copy(out, db.Filenames)
+	return out
 }
 
 // HyphenPrefixes returns cumulative prefixes of s split by '-' but
@@ -172,28 +138,13 @@ func CanonicalToAllForms(canonical string) (forms []string) {
 	return
 }
 
-func initCanonical() {
-	Canonical = make(map[string]string)
+func (db *DB) initCanonical(forms Forms) {
+	db.Canonical = make(map[string]string, len(forms.Canonical)+len(Keywords))
 	for _, kw := range Keywords {
-		Canonical[strings.ToLower(kw)] = kw
-	}
-	for _, alias := range Aliases {
-		Canonical[alias.from] = alias.to
-	}
-	for _, file := range Filenames {
-		for _, form := range CanonicalToAllForms(file) {
-			Canonical[form] = file
-		}
-	}
-}
-
-func addGlob(k, v string) {
-	_, ok := Globs[k]
-	if ok {
-		Globs[k] = append(Globs[k], v)
-	} else {
-		Globs[k] = []string{v}
+		db.Canonical[strings.ToLower(kw)] = kw
 	}
+	db.initAliases()
+	maps.Copy(db.Canonical, forms.Canonical)
 }
 
 func CanonicalToGlobs(canonical string) (globs []string) {
@@ -278,122 +229,181 @@ func CanonicalToGlobs(canonical string) (globs []string) {
 	return
 }
 
-func initGlobs() {
-	Globs = make(map[string][]string)
-	for _, file := range Filenames {
-		for _, glob := range CanonicalToGlobs(file) {
-			addGlob(glob, file)
-		}
-	}
-	for glob, _ := range Globs {
-		Globs[glob] = DedupInPlace(Globs[glob])
-	}
+func (db *DB) initGlobs(forms Forms) {
+	db.Globs = forms.Globs
 }
 
-func initDeprecated() {
-	for _, file := range Filenames {
+func (db *DB) initDeprecated() {
+	db.Deprecated = make(map[string][]string, len(DeprecatedExpressions))
+	for id, expr := range DeprecatedExpressions {
+		db.Deprecated[id] = expr
+	}
+	for _, file := range db.Filenames {
 		if !strings.HasPrefix(file, "deprecated_") {
 			continue
 		}
 		clean := strings.TrimPrefix(file, "deprecated_")
-		_, ok := Deprecated[clean]
+		_, ok := db.Deprecated[clean]
 		if ok {
 			continue
 		}
-		Deprecated[clean] = []string{file}
+		db.Deprecated[clean] = []string{file}
 	}
 }
 
-func init() {
-	initFiles()
-	initDeprecated()
-	initGlobs()
-	initCanonical()
+// RegisterAlias adds from as an exact alternative spelling of the to ID
+// or glob. It fails if to is unknown or if from already resolves to something.
+func (db *DB) RegisterAlias(from, to string) error {
+	key := strings.Join(strings.Fields(strings.ToLower(from)), " ")
+	if key == "" {
+		return fmt.Errorf("%w: %q", ErrInvalidAlias, from)
+	}
+	if _, ok := db.Files[to]; !ok {
+		if _, ok := db.Globs[to]; !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownID, to)
+		}
+	}
+	if _, ok := db.Deprecated[key]; ok {
+		return fmt.Errorf("%w: %s", ErrAliasExists, from)
+	}
+	if _, ok := db.Globs[strings.ToUpper(key)]; ok {
+		return fmt.Errorf("%w: %s", ErrAliasExists, from)
+	}
+	if err := db.addRegisteredAlias(Alias{From: key, To: to}); err != nil {
+		return fmt.Errorf("%w: %s", err, from)
+	}
+	// Caches are built under aliasesMu, so they are reset after it's released
+	db.resetCompletions()
+	db.resetSuggestions()
+	return nil
 }
 
-func TokenToCanonical(token string) string {
+func (db *DB) addRegisteredAlias(alias Alias) error {
+	db.aliasesMu.Lock()
+	defer db.aliasesMu.Unlock()
+	if _, ok := db.Canonical[alias.From]; ok || db.hasAlias(alias.From) {
+		return ErrAliasExists
+	}
+	db.addAlias(alias)
+	db.sortPhrases()
+	db.aliases = append(db.aliases, alias)
+	return nil
+}
+
+func (db *DB) lookupCanonical(token string) (string, bool) {
+	db.aliasesMu.RLock()
+	defer db.aliasesMu.RUnlock()
+	c, ok := db.Canonical[token]
+	return c, ok
+}
+
+func (db *DB) TokenToCanonical(token string) string {
 	token = strings.ToLower(token)
-	if c, ok := Canonical[token]; ok {
+	if c, ok := db.lookupCanonical(token); ok {
 		return strings.TrimPrefix(c, "deprecated_")
 	}
 	upper := strings.ToUpper(token)
-	if _, ok := Globs[upper]; ok {
+	if _, ok := db.Globs[upper]; ok {
 		return upper
 	}
 	trimmed := strings.TrimSuffix(token, "+")
-	if c, ok := Canonical[trimmed]; ok {
+	if c, ok := db.lookupCanonical(trimmed); ok {
 		return strings.TrimPrefix(c, "deprecated_") + "+"
 	}
 	upper = strings.ToUpper(trimmed)
-	if _, ok := Globs[upper]; ok {
+	if _, ok := db.Globs[upper]; ok {
 		return upper + "+"
 	}
 	return token
 }
 
-func TokensToCanonical(tokens []string) []string {
+func TokenToCanonical(token string) string {
+	return Default().TokenToCanonical(token)
+}
+
+func (db *DB) TokensToCanonical(tokens []string) []string {
 	canon := make([]string, 0, len(tokens))
 	for _, token := range tokens {
 		if token == "" || token == " " {
 			continue
 		}
 		token := strings.ToLower(token)
-		depr, ok := Deprecated[token]
+		depr, ok := db.Deprecated[token]
 		if ok {
-			canon = append(canon, TokensToCanonical(depr)...)
+			canon = append(canon, db.TokensToCanonical(depr)...)
 			continue
 		}
-		canon = append(canon, TokenToCanonical(token))
+		canon = append(canon, db.TokenToCanonical(token))
 	}
 	return canon
 }
 
+func (db *DB) Tokenise(text string) []string {
+	return db.TokeniseWith(text, nil)
+}
+
 func Tokenise(text string) []string {
-	for _, banned := range []string{"\t", "\n", "\r"} {
-		text = strings.ReplaceAll(text, banned, " ")
-	}
-	return TokensToCanonical(strings.Split(text, " "))
+	return Default().Tokenise(text)
 }
 
-func TokensToShort(tokens []string) map[string]string {
+// TokensToShort maps every non-keyword token to the shortest glob that
+// matches it and none of the other tokens, or to the token itself if
+// there is no such glob. Among globs of the same length the
+// lexicographically first one is used.
+func (db *DB) TokensToShort(tokens []string) map[string]string {
+	globsByID := db.globsByID()
 	mapping := make(map[string]string)
 	for _, token := range tokens {
-		if slices.Contains(Keywords, token) {
-			continue
+		if !slices.Contains(Keywords, token) {
+			mapping[token] = token
 		}
-		short := token
-		for glob, matches := range Globs {
-			if len(glob) >= len(short) {
-				continue
-			}
-			if !slices.Contains(matches, token) {
-				continue
-			}
-			ok := true
-			for _, another_token := range tokens {
-				if another_token == token {
-					continue
-				}
-				if slices.Contains(Keywords, another_token) {
-					continue
-				}
-				if slices.Contains(matches, another_token) {
-					ok = false
-					break
-				}
+	}
+	// Number of distinct tokens each glob matches
+	matched := make(map[string]int)
+	for token := range mapping {
+		for _, glob := range globsByID[token] {
+			matched[glob]++
+		}
+	}
+	for token := range mapping {
+		for _, glob := range globsByID[token] {
+			if len(glob) >= len(token) {
+				break
 			}
-			if ok {
-				short = glob
+			if matched[glob] == 1 {
+				mapping[token] = glob
+				break
 			}
 		}
-		mapping[token] = short
 	}
 	return mapping
 }
 
-func ToShort(text string) string {
-	tokens := Tokenise(text)
-	mapping := TokensToShort(tokens)
+// GlobsOf returns globs matching id, shortest first.
+func (db *DB) GlobsOf(id string) []string {
+	return slices.Clone(db.globsByID()[id])
+}
+
+// buildGlobsByID inverts Globs, globs of each ID are ordered by length
+// and then lexicographically.
+func (db *DB) buildGlobsByID() map[string][]string {
+	index := make(map[string][]string)
+	for glob, matches := range db.Globs {
+		for _, id := range matches {
+			index[id] = append(index[id], glob)
+		}
+	}
+	for _, globs := range index {
+		slices.SortFunc(globs, func(a, b string) int {
+			return cmp.Or(cmp.Compare(len(a), len(b)), strings.Compare(a, b))
+		})
+	}
+	return index
+}
+
+func (db *DB) ToShort(text string) string {
+	tokens := db.Tokenise(text)
+	mapping := db.TokensToShort(tokens)
 	for i := range len(tokens) {
 		if slices.Contains(Keywords, tokens[i]) {
 			continue
@@ -404,23 +414,27 @@ func ToShort(text string) string {
 	return strings.Join(strings.Fields(text), " ")
 }
 
-func GetGlobs(token string) []string {
+func ToShort(text string) string {
+	return Default().ToShort(text)
+}
+
+func (db *DB) GetGlobs(token string) []string {
 	result := []string{token}
-	if g, ok := Globs[token]; ok {
+	if g, ok := db.Globs[token]; ok {
 		result = append(result, g...)
 	}
 	return result
 }
 
-func AreTokensMatching(a, b string) bool {
+func (db *DB) AreTokensMatching(a, b string) bool {
 	if a == b {
 		return true
 	}
 	if a+"+" == b || a == b+"+" {
 		return true
 	}
-	variantsA := GetGlobs(a)
-	variantsB := GetGlobs(b)
+	variantsA := db.GetGlobs(a)
+	variantsB := db.GetGlobs(b)
 	for _, va := range variantsA {
 		if slices.Contains(variantsB, va) {
 			return true
@@ -429,7 +443,11 @@ func AreTokensMatching(a, b string) bool {
 	return false
 }
 
-func SeparateTokenList(tokens []string) (licenses, exceptions []string) {
+func AreTokensMatching(a, b string) bool {
+	return Default().AreTokensMatching(a, b)
+}
+
+func (db *DB) SeparateTokenList(tokens []string) (licenses, exceptions []string) {
 	licenses = make([]string, 0, len(tokens))
 	exceptions = make([]string, 0, len(tokens))
 	for _, token := range tokens {
@@ -437,7 +455,7 @@ func SeparateTokenList(tokens []string) (licenses, exceptions []string) {
 		if token == "" || token == " " || slices.Contains(Keywords, upper) {
 			continue
 		}
-		if slices.Contains(ExceptionsList, token) {
+		if db.IsException(token) {
 			exceptions = append(exceptions, token)
 			continue
 		}
@@ -446,11 +464,15 @@ func SeparateTokenList(tokens []string) (licenses, exceptions []string) {
 	return
 }
 
-func AreListsMatching(a, b []string) bool {
+func SeparateTokenList(tokens []string) (licenses, exceptions []string) {
+	return Default().SeparateTokenList(tokens)
+}
+
+func (db *DB) AreListsMatching(a, b []string) bool {
 	for _, ea := range a {
 		matching := false
 		for _, eb := range b {
-			if AreTokensMatching(ea, eb) {
+			if db.AreTokensMatching(ea, eb) {
 				matching = true
 				break
 			}
@@ -462,32 +484,40 @@ func AreListsMatching(a, b []string) bool {
 	return true
 }
 
-func areTokensListsMatching(a, b []string) bool {
-	al, ae := SeparateTokenList(a)
-	bl, be := SeparateTokenList(b)
-	if len(ae) > 0 && len(be) > 0 && !AreListsMatching(ae, be) {
+func AreListsMatching(a, b []string) bool {
+	return Default().AreListsMatching(a, b)
+}
+
+func (db *DB) areTokensListsMatching(a, b []string) bool {
+	al, ae := db.SeparateTokenList(a)
+	bl, be := db.SeparateTokenList(b)
+	if len(ae) > 0 && len(be) > 0 && !db.AreListsMatching(ae, be) {
 		return false
 	}
-	return AreListsMatching(al, bl)
+	return db.AreListsMatching(al, bl)
 }
 
-func AreTokensListsMatchingSwap(a, b []string) bool {
-	return areTokensListsMatching(a, b) && areTokensListsMatching(b, a)
+func (db *DB) AreTokensListsMatchingSwap(a, b []string) bool {
+	return db.areTokensListsMatching(a, b) && db.areTokensListsMatching(b, a)
 }
 
-func GlobToFirstMatch(glob string) string {
-	if _, ok := Files[glob]; ok {
+func (db *DB) GlobToFirstMatch(glob string) string {
+	if _, ok := db.Files[glob]; ok {
 		return glob
 	}
-	globs, ok := Globs[glob]
+	globs, ok := db.Globs[glob]
 	if !ok {
 		return glob
 	}
 	for _, g := range globs {
-		r := GlobToFirstMatch(g)
+		r := db.GlobToFirstMatch(g)
 		if r != glob {
 			return r
 		}
 	}
 	return glob
 }
+
+func GlobToFirstMatch(glob string) string {
+	return Default().GlobToFirstMatch(glob)
+}
@@ -3,6 +3,7 @@ package internal_test
 import (
 	"fmt"
 	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/asciimoth/licensedb/internal"
@@ -191,6 +192,55 @@ func Test_ToShort(t *testing.T) {
 	}
 }
 
+// Brute force TokensToShort
+func tokensToShortReference(db *internal.DB, tokens []string) map[string]string {
+	mapping := make(map[string]string)
+	for _, token := range tokens {
+		if slices.Contains(internal.Keywords, token) {
+			continue
+		}
+		short := token
+		for glob, matches := range db.Globs {
+			if len(glob) >= len(token) || !slices.Contains(matches, token) {
+				continue
+			}
+			if slices.ContainsFunc(tokens, func(another string) bool {
+				return another != token && slices.Contains(matches, another)
+			}) {
+				continue
+			}
+			if short == token || len(glob) < len(short) || len(glob) == len(short) && glob < short {
+				short = glob
+			}
+		}
+		mapping[token] = short
+	}
+	return mapping
+}
+
+func Test_TokensToShortReference(t *testing.T) {
+	t.Parallel()
+	db := internal.Default()
+	files := db.List()
+	for i := 0; i < len(files); i += 7 {
+		tokens := slices.Concat(files[i:min(i+20, len(files))], []string{"OR", "MIT"})
+		want := tokensToShortReference(db, tokens)
+		for range 3 {
+			if got := db.TokensToShort(tokens); !reflect.DeepEqual(got, want) {
+				t.Fatalf("TokensToShort(%v) = %v; want %v", tokens, got, want)
+			}
+		}
+	}
+}
+
+func BenchmarkTokensToShort(b *testing.B) {
+	db := internal.Default()
+	tokens := db.List()
+	for b.Loop() {
+		db.TokensToShort(tokens)
+	}
+}
+
 func Test_AreTokensMatching(t *testing.T) {
 	// internal.DebugPrintGlobs()
 	tests := []struct {
@@ -0,0 +1,241 @@
+package internal
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// DB holds license list data and indexes derived from it.
+// It is safe for concurrent use.
+type DB struct {
+	fsys fs.FS
+	// Version of SPDX license list, like "3.27.0".
+	// Empty if it is unknown.
+	ListVersion string
+	// Paths of text files in fsys by file name
+	Files     map[string]string
+	Filenames []string
+	// Paths of template files in fsys by file name.
+	// Empty if archive has no templates.
+	Templates map[string]string
+	Globs     map[string][]string
+	Canonical map[string]string
+	// Map of Deprecated IDs to expressions
+	// Result of mapping may be ambiguous
+	Deprecated map[string][]string
+	// Metadata by SPDX ID (without "deprecated_" prefix).
+	// Empty if archive was generated without json/ directory.
+	Metadata map[string]*Meta
+	// SPDX exception IDs, sorted
+	ExceptionsList []string
+	// License IDs related to each exception
+	RelatedLicenses map[string][]string
+
+	// Guards Canonical, aliases and tables derived from them against
+	// concurrent RegisterAlias calls
+	aliasesMu sync.RWMutex
+	aliases   []Alias
+	// Lossy single word aliases, applied only on request
+	lossyAliases map[string]string
+	// Multi-word aliases, longest first
+	phraseAliases []Alias
+
+	completeMu   sync.Mutex
+	completeTrie *Trie
+
+	suggestMu   sync.Mutex
+	suggestKeys []suggestKey
+
+	texts textCache
+	// Set for archives embedded with licensedb_notext build tag, which
+	// have empty files in place of texts
+	noText bool
+	// Set if equal texts share the same path in Files
+	contentAddressed bool
+	// Nil if archive has no manifest
+	manifest *Manifest
+
+	globsByID func() map[string][]string
+
+	names func() *NameIndex
+	urls  func() *URLIndex
+}
+
+// Layouts of license data directories
+type layout struct {
+	// Directory with license texts
+	dir string
+	// Extension of text files
+	ext string
+	// File mapping names to texts in dir by their hashes.
+	// Empty if texts are named after IDs.
+	index string
+}
+
+var (
+	// Contents of archive produced by genembed.go:
+	// "index.json", "blobs/<sha256>", "json/..."
+	indexedLayout = layout{"blobs", "", "index.json"}
+	// Contents of archive produced by older genembed.go: "<ID>", "json/..."
+	embeddedLayout = layout{".", "", ""}
+	// license-list-data repository: "text/<ID>.txt", "json/..."
+	releaseLayout = layout{"text", ".txt", ""}
+)
+
+// detectLayout returns root of license data in fsys and its layout.
+// Data may be wrapped in single top-level directory, like in release
+// archives of license-list-data.
+func detectLayout(fsys fs.FS) (fs.FS, layout, error) {
+	for {
+		if _, err := fs.Stat(fsys, indexedLayout.index); err == nil {
+			return fsys, indexedLayout, nil
+		}
+		if info, err := fs.Stat(fsys, releaseLayout.dir); err == nil && info.IsDir() {
+			return fsys, releaseLayout, nil
+		}
+		entries, err := fs.ReadDir(fsys, ".")
+		if err != nil {
+			return nil, layout{}, err
+		}
+		entries = slices.DeleteFunc(entries, func(e fs.DirEntry) bool {
+			return strings.HasPrefix(e.Name(), ".")
+		})
+		if len(entries) != 1 || !entries[0].IsDir() || entries[0].Name() == "json" {
+			return fsys, embeddedLayout, nil
+		}
+		if fsys, err = fs.Sub(fsys, entries[0].Name()); err != nil {
+			return nil, layout{}, err
+		}
+	}
+}
+
+// NewDB builds database from fsys with either license-list-data
+// repository layout (text/<ID>.txt) or layout of embedded archive
+// (<ID> files in root). License list metadata is read from json/
+// directory if there is one.
+func NewDB(fsys fs.FS) (*DB, error) {
+	fsys, l, err := detectLayout(fsys)
+	if err != nil {
+		return nil, err
+	}
+	db := &DB{fsys: fsys}
+	if err := db.initFiles(l); err != nil {
+		return nil, err
+	}
+	if err := db.loadTemplates(l); err != nil {
+		return nil, err
+	}
+	meta, version, err := loadMeta(fsys)
+	if err != nil {
+		return nil, err
+	}
+	db.Metadata = meta
+	db.ListVersion = version
+	if err := db.loadManifest(); err != nil {
+		return nil, err
+	}
+	if db.ListVersion == "" && db.manifest != nil {
+		db.ListVersion = db.manifest.ListVersion
+	}
+	forms, err := db.loadForms()
+	if err != nil {
+		return nil, err
+	}
+	db.initDeprecated()
+	db.initGlobs(forms)
+	db.initCanonical(forms)
+	if err := db.initExceptions(); err != nil {
+		return nil, err
+	}
+	db.globsByID = sync.OnceValue(db.buildGlobsByID)
+	db.names = sync.OnceValue(func() *NameIndex {
+		return NewNameIndex(db, db.Metadata)
+	})
+	db.urls = sync.OnceValue(func() *URLIndex {
+		return NewURLIndex(db, db.Metadata)
+	})
+	return db, nil
+}
+
+// NewZipDB builds database from zip archive with NewDB.
+func NewZipDB(r io.ReaderAt, size int64) (*DB, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	// Archives generated by older genembed.go have an entry with empty
+	// name that makes zip.Reader an invalid fs.FS
+	zr.File = slices.DeleteFunc(zr.File, func(f *zip.File) bool {
+		return f.Name == ""
+	})
+	return NewDB(zr)
+}
+
+// Default returns database of embedded license list of DefaultVersion.
+// It is built on first call.
+var Default = sync.OnceValue(func() *DB {
+	db, err := Embedded(DefaultVersion)
+	if err != nil {
+		// There should not be errors while working with embedded archive
+		panic(err)
+	}
+	return db
+})
+
+func (db *DB) initFiles(l layout) error {
+	if l.index != "" {
+		return db.initIndexedFiles(l)
+	}
+	entries, err := fs.ReadDir(db.fsys, l.dir)
+	if err != nil {
+		return err
+	}
+	db.Files = make(map[string]string, len(entries))
+	db.Filenames = make([]string, 0, len(entries))
+	for _, e := range entries {
+		// Metadata is stored in json/ next to texts
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		name, ok := strings.CutSuffix(e.Name(), l.ext)
+		if !ok || name == "" {
+			continue
+		}
+		db.Files[name] = path.Join(l.dir, e.Name())
+		db.Filenames = append(db.Filenames, name)
+	}
+	if len(db.Filenames) == 0 {
+		return ErrNoLicenses
+	}
+	return nil
+}
+
+func (db *DB) initIndexedFiles(l layout) error {
+	data, err := fs.ReadFile(db.fsys, l.index)
+	if err != nil {
+		return err
+	}
+	var index map[string]string
+	if err := json.Unmarshal(data, &index); err != nil {
+		return fmt.Errorf("%s: %w", l.index, err)
+	}
+	db.Files = make(map[string]string, len(index))
+	db.Filenames = make([]string, 0, len(index))
+	for name, hash := range index {
+		db.Files[name] = path.Join(l.dir, hash)
+		db.Filenames = append(db.Filenames, name)
+	}
+	slices.Sort(db.Filenames)
+	db.contentAddressed = true
+	if len(db.Filenames) == 0 {
+		return ErrNoLicenses
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package internal_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+func fixtureDB(t *testing.T) *internal.DB {
+	t.Helper()
+	db, err := internal.NewDB(fstest.MapFS{
+		"index.json": {Data: []byte(`{
+			"MIT": "mit", "GPL-2.0-only": "gpl2", "GPL-2.0-or-later": "gpl2+",
+			"deprecated_GPL-2.0": "gpl2-deprecated", "Classpath-exception-2.0": "classpath"
+		}`)},
+		"blobs/mit":             {Data: []byte("MIT License")},
+		"blobs/gpl2":            {Data: []byte("GPL 2 only")},
+		"blobs/gpl2+":           {Data: []byte("GPL 2 or later")},
+		"blobs/gpl2-deprecated": {Data: []byte("GPL 2")},
+		"blobs/classpath":       {Data: []byte("Classpath")},
+		internal.ExceptionsFile: {Data: []byte(`["Classpath-exception-2.0"]`)},
+	})
+	if err != nil {
+		t.Fatalf("NewDB() error: %v", err)
+	}
+	return db
+}
+
+func Test_NewDB(t *testing.T) {
+	db := fixtureDB(t)
+
+	if got, want := db.List(), []string{
+		"Classpath-exception-2.0", "GPL-2.0-only", "GPL-2.0-or-later", "MIT", "deprecated_GPL-2.0",
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("List() = %v; want %v", got, want)
+	}
+	if got := *db.GetText("MIT"); got != "MIT License" {
+		t.Fatalf("GetText(MIT) = %v; want MIT License", got)
+	}
+	got := db.Tokenise("mit OR gpl2+ OR apache-2.0 WITH classpath-exception-2.0")
+	want := []string{"MIT", "OR", "GPL-2.0-or-later", "OR", "apache-2.0", "WITH", "Classpath-exception-2.0"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Tokenise() = %v; want %v", got, want)
+	}
+	if !db.IsException("Classpath-exception-2.0") {
+		t.Fatalf("IsException(Classpath-exception-2.0) = false; want true")
+	}
+
+	// Databases don't share aliases
+	other := fixtureDB(t)
+	if err := db.RegisterAlias("corp", "MIT"); err != nil {
+		t.Fatalf("RegisterAlias(corp, MIT) error: %v", err)
+	}
+	if got := other.TokenToCanonical("corp"); got != "corp" {
+		t.Fatalf("TokenToCanonical(corp) = %v in other database; want corp", got)
+	}
+	if got := db.TokenToCanonical("corp"); got != "MIT" {
+		t.Fatalf("TokenToCanonical(corp) = %v; want MIT", got)
+	}
+}
+
+func Test_NewDBLayouts(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+	}{
+		{"embedded", fstest.MapFS{
+			"MIT":                {Data: []byte("MIT License")},
+			"json/licenses.json": {Data: []byte(`{"licenses": [{"licenseId": "MIT", "name": "MIT License"}]}`)},
+		}},
+		{"indexed", fstest.MapFS{
+			"index.json":         {Data: []byte(`{"MIT": "abc"}`)},
+			"blobs/abc":          {Data: []byte("MIT License")},
+			"json/licenses.json": {Data: []byte(`{"licenses": [{"licenseId": "MIT", "name": "MIT License"}]}`)},
+		}},
+		{"repository", fstest.MapFS{
+			"text/MIT.txt":       {Data: []byte("MIT License")},
+			"text/README":        {Data: []byte("not a license")},
+			"json/licenses.json": {Data: []byte(`{"licenses": [{"licenseId": "MIT", "name": "MIT License"}]}`)},
+		}},
+		{"release archive", fstest.MapFS{
+			"license-list-data-3.27.0/text/MIT.txt":       {Data: []byte("MIT License")},
+			"license-list-data-3.27.0/json/licenses.json": {Data: []byte(`{"licenses": [{"licenseId": "MIT", "name": "MIT License"}]}`)},
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, err := internal.NewDB(tc.fsys)
+			if err != nil {
+				t.Fatalf("NewDB() error: %v", err)
+			}
+			if got := db.List(); !reflect.DeepEqual(got, []string{"MIT"}) {
+				t.Fatalf("List() = %v; want [MIT]", got)
+			}
+			if got := db.GetText("MIT"); got == nil || *got != "MIT License" {
+				t.Fatalf("GetText(MIT) = %v; want MIT License", got)
+			}
+			if m, ok := db.GetMeta("mit"); !ok || m.Name != "MIT License" {
+				t.Fatalf("GetMeta(mit) = %v, %v; want MIT License", m, ok)
+			}
+		})
+	}
+
+	if _, err := internal.NewDB(fstest.MapFS{"json/licenses.json": {Data: []byte(`{}`)}}); !errors.Is(err, internal.ErrNoLicenses) {
+		t.Fatalf("NewDB(no texts) error = %v; want %v", err, internal.ErrNoLicenses)
+	}
+}
+
+func Test_Manifest(t *testing.T) {
+	t.Parallel()
+	db, err := internal.NewDB(fstest.MapFS{
+		"index.json":    {Data: []byte(`{"MIT": "a", "X11": "b"}`)},
+		"blobs/a":       {Data: []byte("MIT License")},
+		"blobs/b":       {Data: []byte("X11 License")},
+		"manifest.json": {Data: []byte(`{"licenseListVersion": "1.2", "source": "v1.2.zip", "files": 2, "texts": 2}`)},
+	})
+	if err != nil {
+		t.Fatalf("NewDB() error: %v", err)
+	}
+	got, ok := db.Manifest()
+	want := internal.Manifest{
+		ListVersion: "1.2",
+		Source:      "v1.2.zip",
+		Files:       2,
+		Texts:       2,
+		Hashes:      map[string]string{"MIT": "a", "X11": "b"},
+	}
+	if !ok || !reflect.DeepEqual(got, want) {
+		t.Fatalf("Manifest() = %+v, %v; want %+v", got, ok, want)
+	}
+	if db.ListVersion != "1.2" {
+		t.Fatalf("ListVersion = %v; want 1.2", db.ListVersion)
+	}
+	if _, ok := fixtureDB(t).Manifest(); ok {
+		t.Fatalf("Manifest() without manifest file = _, true; want false")
+	}
+}
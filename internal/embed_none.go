@@ -0,0 +1,15 @@
+//go:build licensedb_noembed
+
+package internal
+
+import "embed"
+
+// Build without embedded archives, used by genembed.go so it can run
+// before archives are generated. Embedded and Default fail in it.
+var archives embed.FS
+
+const (
+	archiveDir     = "."
+	embeddedTexts  = true
+	DefaultVersion = ""
+)
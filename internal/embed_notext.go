@@ -0,0 +1,22 @@
+// Code generated by genembed.go; DO NOT EDIT.
+
+//go:build licensedb_notext && !licensedb_noembed
+
+package internal
+
+import "embed"
+
+// Embedded license list archives, one per version, with shared
+// archive of their texts unless built without texts
+//
+//go:embed notext/spdx3.27.0.zip
+var archives embed.FS
+
+// Directory of archives in embedded FS
+const archiveDir = "notext"
+
+// Whether embedded archives contain license texts
+const embeddedTexts = false
+
+// Version of license list used by default
+const DefaultVersion = "3.27.0"
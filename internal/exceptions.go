@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"slices"
+	"strings"
+)
+
+// File with sorted IDs of license exceptions in archives produced by
+// genembed.go. Used for databases without json/exceptions.json.
+const ExceptionsFile = "exceptions.json"
+
+// IsException reports if id is an SPDX license exception.
+func (db *DB) IsException(id string) bool {
+	_, ok := slices.BinarySearch(db.ExceptionsList, id)
+	return ok
+}
+
+// mentionedLicenses returns IDs of licenses (not exceptions) mentioned in
+// free form text, like "Typically used with GPL-2.0-only or GPL-2.0-or-later".
+func (db *DB) mentionedLicenses(text string) []string {
+	found := make([]string, 0)
+	for _, word := range strings.Fields(text) {
+		word = strings.Trim(word, ".,;:()[]\"'")
+		if _, ok := db.Files[word]; !ok || db.IsException(word) {
+			continue
+		}
+		found = append(found, word)
+	}
+	return found
+}
+
+func (db *DB) initRelatedLicenses() {
+	db.RelatedLicenses = make(map[string][]string)
+	for _, id := range db.ExceptionsList {
+		if m, ok := db.Metadata[id]; ok {
+			db.RelatedLicenses[id] = db.mentionedLicenses(m.Comment)
+		}
+	}
+	// Deprecated IDs like "GPL-3.0-with-GCC-exception" pair license with exception
+	for _, expr := range db.Deprecated {
+		i := slices.Index(expr, "with")
+		if i < 1 || i+1 >= len(expr) {
+			continue
+		}
+		license, exception := db.Canonical[expr[i-1]], db.Canonical[expr[i+1]]
+		if license == "" || !db.IsException(exception) {
+			continue
+		}
+		db.RelatedLicenses[exception] = append(db.RelatedLicenses[exception], license)
+	}
+	for id, related := range db.RelatedLicenses {
+		slices.Sort(related)
+		db.RelatedLicenses[id] = slices.Compact(related)
+	}
+}
+
+// ExceptionIDs returns sorted IDs of exceptions in metadata.
+func ExceptionIDs(meta map[string]*Meta) []string {
+	ids := make([]string, 0)
+	for id, m := range meta {
+		if m.IsException {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// initExceptions finds exceptions in metadata or, if there is none, in
+// ExceptionsFile.
+func (db *DB) initExceptions() error {
+	db.ExceptionsList = ExceptionIDs(db.Metadata)
+	if len(db.ExceptionsList) == 0 {
+		if _, err := readJSON(db.fsys, ExceptionsFile, &db.ExceptionsList); err != nil {
+			return err
+		}
+		slices.Sort(db.ExceptionsList)
+	}
+	db.initRelatedLicenses()
+	for id, m := range db.Metadata {
+		m.RelatedLicenses = db.RelatedLicenses[id]
+	}
+	return nil
+}
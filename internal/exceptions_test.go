@@ -0,0 +1,106 @@
+package internal_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+func Test_ExceptionsList(t *testing.T) {
+	db := internal.Default()
+	for _, id := range db.ExceptionsList {
+		if _, ok := db.Files[id]; !ok {
+			t.Errorf("exception %v is not in archive", id)
+		}
+	}
+	if !slices.IsSorted(db.ExceptionsList) {
+		t.Fatalf("ExceptionsList is not sorted")
+	}
+
+	cases := []struct {
+		name string
+		fsys fstest.MapFS
+		want []string
+	}{
+		{"metadata", fstest.MapFS{
+			"text/MIT.txt":           {Data: []byte("MIT")},
+			"text/Foo-exception.txt": {Data: []byte("Foo")},
+			"json/exceptions.json":   {Data: []byte(`{"exceptions":[{"licenseExceptionId":"Foo-exception"}]}`)},
+			// Metadata takes precedence
+			internal.ExceptionsFile: {Data: []byte(`["MIT"]`)},
+		}, []string{"Foo-exception"}},
+		{"exceptions file", fstest.MapFS{
+			"index.json":            {Data: []byte(`{"MIT": "a", "Foo-exception": "b", "Bar-exception": "c"}`)},
+			"blobs/a":               {Data: []byte("MIT")},
+			"blobs/b":               {Data: []byte("Foo")},
+			"blobs/c":               {Data: []byte("Bar")},
+			internal.ExceptionsFile: {Data: []byte(`["Foo-exception","Bar-exception"]`)},
+		}, []string{"Bar-exception", "Foo-exception"}},
+		{"none", fstest.MapFS{
+			"MIT":           {Data: []byte("MIT")},
+			"Foo-exception": {Data: []byte("Foo")},
+		}, []string{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			db, err := internal.NewDB(c.fsys)
+			if err != nil {
+				t.Fatalf("NewDB() error: %v", err)
+			}
+			if !reflect.DeepEqual(db.ExceptionsList, c.want) {
+				t.Fatalf("ExceptionsList = %v; want %v", db.ExceptionsList, c.want)
+			}
+		})
+	}
+}
+
+func Test_IsException(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"LLVM-exception", true},
+		{"Classpath-exception-2.0", true},
+		{"SHL-2.1", true},
+		{"SHL-0.51", false},
+		{"MPL-2.0-no-copyleft-exception", false},
+		{"GPL-3.0-or-later", false},
+		{"", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			got := internal.Default().IsException(tc.in)
+			if got != tc.want {
+				t.Fatalf("IsException(%v) = %v; want %v", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func Test_RelatedLicenses(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"Autoconf-exception-3.0", []string{"GPL-3.0-or-later"}},
+		{"GCC-exception-3.1", []string{"GPL-3.0-or-later"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			got := internal.Default().RelatedLicenses[tc.in]
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("RelatedLicenses[%v] = %v; want %v", tc.in, got, tc.want)
+			}
+		})
+	}
+}
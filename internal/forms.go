@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"slices"
+)
+
+// File with precomputed Forms in archives produced by genembed.go
+const FormsFile = "forms.json"
+
+// Forms are lookup tables derived from file names. Computing them takes
+// most of the time of building database, so genembed.go stores them in
+// archives.
+type Forms struct {
+	// Ambiguous short forms to files they may mean
+	Globs map[string][]string `json:"globs"`
+	// Lowercase alternative forms to files
+	Canonical map[string]string `json:"canonical"`
+}
+
+// ComputeForms derives Forms from file names with CanonicalToGlobs and
+// CanonicalToAllForms.
+func ComputeForms(filenames []string) Forms {
+	f := Forms{
+		Globs:     make(map[string][]string),
+		Canonical: make(map[string]string),
+	}
+	for _, file := range filenames {
+		for _, glob := range CanonicalToGlobs(file) {
+			f.Globs[glob] = append(f.Globs[glob], file)
+		}
+		for _, form := range CanonicalToAllForms(file) {
+			f.Canonical[form] = file
+		}
+	}
+	for glob := range f.Globs {
+		f.Globs[glob] = DedupInPlace(f.Globs[glob])
+	}
+	return f
+}
+
+// loadForms reads FormsFile from fsys or computes forms if there is none.
+func (db *DB) loadForms() (Forms, error) {
+	data, err := fs.ReadFile(db.fsys, FormsFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return ComputeForms(db.Filenames), nil
+	}
+	if err != nil {
+		return Forms{}, err
+	}
+	var f Forms
+	if err := json.Unmarshal(data, &f); err != nil {
+		return Forms{}, fmt.Errorf("%s: %w", FormsFile, err)
+	}
+	return f, nil
+}
+
+// Equal reports whether f and o have the same tables.
+func (f Forms) Equal(o Forms) bool {
+	return maps.Equal(f.Canonical, o.Canonical) &&
+		maps.EqualFunc(f.Globs, o.Globs, slices.Equal)
+}
@@ -0,0 +1,101 @@
+package internal_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+func readArchive(tb testing.TB) []byte {
+	tb.Helper()
+	data, err := os.ReadFile(filepath.Join("notext", "spdx"+internal.DefaultVersion+".zip"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return data
+}
+
+// FS hiding precomputed forms
+type noFormsFS struct {
+	fs.FS
+}
+
+func (f noFormsFS) Open(name string) (fs.File, error) {
+	if name == internal.FormsFile {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return f.FS.Open(name)
+}
+
+func Test_EmbeddedForms(t *testing.T) {
+	t.Parallel()
+	data := readArchive(t)
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := fs.ReadFile(zr, internal.FormsFile)
+	if err != nil {
+		t.Fatalf("ReadFile(%v) error: %v", internal.FormsFile, err)
+	}
+	var got internal.Forms
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(internal.ComputeForms(internal.Default().Filenames)) {
+		t.Fatalf("%v is stale, run go generate", internal.FormsFile)
+	}
+
+	computed, err := internal.NewDB(noFormsFS{zr})
+	if err != nil {
+		t.Fatalf("NewDB() error: %v", err)
+	}
+	for _, in := range []string{"gpl3+", "apache2", "bsd-3", "lgpl-2.1+"} {
+		if got, want := computed.TokenToCanonical(in), internal.Default().TokenToCanonical(in); got != want {
+			t.Fatalf("TokenToCanonical(%v) = %v; want %v", in, got, want)
+		}
+	}
+}
+
+func Test_LoadFormsError(t *testing.T) {
+	t.Parallel()
+	_, err := internal.NewDB(fstest.MapFS{
+		"index.json":       {Data: []byte(`{"MIT": "a"}`)},
+		"blobs/a":          {Data: []byte("MIT License")},
+		internal.FormsFile: {Data: []byte(`{`)},
+	})
+	var syntaxErr *json.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Fatalf("NewDB(broken forms) error = %v; want JSON syntax error", err)
+	}
+}
+
+func BenchmarkNewZipDB(b *testing.B) {
+	data := readArchive(b)
+	for b.Loop() {
+		if _, err := internal.NewZipDB(bytes.NewReader(data), int64(len(data))); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNewZipDBComputeForms(b *testing.B) {
+	data := readArchive(b)
+	for b.Loop() {
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := internal.NewDB(noFormsFS{zr}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
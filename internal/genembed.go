@@ -3,24 +3,73 @@
 package main
 
 import (
+	"archive/tar"
 	"archive/zip"
 	"bytes"
+	"cmp"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
 	"go/format"
 	"io"
+	"io/fs"
+	"maps"
 	"net/http"
 	"os"
+	"path"
+	"path/filepath"
+	"slices"
 	"strings"
+
+	"github.com/asciimoth/licensedb/internal"
 )
 
 const template = "" +
 	"// Code generated by genembed.go; DO NOT EDIT.\n\n" +
+	"//go:build %s\n\n" +
 	"package %s\n" +
-	"import _ \"embed\"\n" +
+	"import \"embed\"\n" +
+	"// Embedded license list archives, one per version, with shared\n" +
+	"// archive of their texts unless built without texts\n" +
+	"//\n" +
 	"//go:embed %s\n" +
-	"var archive []byte"
+	"var archives embed.FS\n" +
+	"// Directory of archives in embedded FS\n" +
+	"const archiveDir = %q\n" +
+	"// Whether embedded archives contain license texts\n" +
+	"const embeddedTexts = %t\n" +
+	"// Version of license list used by default\n" +
+	"const DefaultVersion = %q"
+
+// Archives of all versions are named spdx<version>.zip
+const archivePattern = "spdx*.zip"
+
+// Directory of embedded archives of versions. They have no license
+// texts, which are stored once in internal.BlobsArchive. With
+// licensedb_notext build tag they are embedded alone.
+const noTextDir = "notext"
+
+// archiveVersion returns version of archive like spdx3.27.0.zip.
+func archiveVersion(name string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(filepath.Base(name), "spdx"), ".zip")
+}
+
+// embeddedArchives returns names of license list archives in noTextDir,
+// oldest version first.
+func embeddedArchives() ([]string, error) {
+	names, err := filepath.Glob(filepath.Join(noTextDir, archivePattern))
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		return internal.CompareVersions(archiveVersion(a), archiveVersion(b))
+	})
+	return names, nil
+}
 
 func fatalf(msg string, args ...interface{}) {
 	fmt.Fprintf(os.Stderr, msg+"\n", args...)
@@ -28,112 +77,693 @@ func fatalf(msg string, args ...interface{}) {
 }
 
 func main() {
-	url := flag.String("url", "", "URL of zip archive to download")
-	name := flag.String("name", "", "output zip filename to create and embed (e.g. assets.zip)")
+	url := flag.String("url", "", "URL of license-list-data release archive to download")
+	src := flag.String("src", "", "local license-list-data release zip, tarball (.tar, .tar.gz, .tgz) or checkout directory to use instead of -url")
+	sum := flag.String("sha256", "", "expected SHA-256 of archive from -url or -src, required with -url")
+	name := flag.String("name", "", "archive to create in "+noTextDir+", like spdx3.27.0.zip; every archive there is embedded")
+	force := flag.Bool("force", false, "regenerate output zip even if it exists")
 	flag.Parse()
 
-	if *url == "" || *name == "" {
-		fmt.Fprintln(os.Stderr, "required: -url and -name")
+	if (*url == "") == (*src == "") || *name == "" {
+		fmt.Fprintln(os.Stderr, "required: -name and one of -url and -src")
 		flag.Usage()
 		os.Exit(2)
 	}
 
-	if _, err := os.Stat(*name); errors.Is(err, os.ErrNotExist) {
-		err := produceArchive(*url, *name)
+	tmp, err := os.MkdirTemp("", "genembed")
+	if err != nil {
+		fatalf("%v", err)
+	}
+	if err := generate(*url, *src, *sum, *name, *force, tmp); err != nil {
+		os.RemoveAll(tmp)
+		fatalf("%v", err)
+	}
+	os.RemoveAll(tmp)
+}
+
+// generate writes archive of version name to noTextDir and its texts to
+// internal.BlobsArchive, then Go files embedding all archives. Full
+// archives with texts are only written to tmp.
+func generate(url, src, sum, name string, force bool, tmp string) error {
+	// Archives with texts to take blobs from
+	var full []string
+	// Full archives in current directory are left by older genembed.go
+	legacy, err := filepath.Glob(archivePattern)
+	if err != nil {
+		return err
+	}
+	for _, l := range legacy {
+		if err := upgradeArchive(l); err != nil {
+			return err
+		}
+		full = append(full, l)
+	}
+	if _, err := os.Stat(filepath.Join(noTextDir, name)); force || errors.Is(err, os.ErrNotExist) {
+		files, source, err := loadSource(url, src, sum)
 		if err != nil {
-			fmt.Fprintln(os.Stderr, err)
-			os.Exit(2)
+			return err
+		}
+		p := filepath.Join(tmp, name)
+		if err := produceArchive(files, source, p); err != nil {
+			return err
 		}
+		full = append(full, p)
 	} else {
-		fmt.Printf("%s already exists\n", *name)
+		fmt.Printf("%s already exists\n", name)
+	}
+	for _, f := range full {
+		if err := produceNoTextArchive(f, filepath.Join(noTextDir, filepath.Base(f))); err != nil {
+			return err
+		}
+	}
+
+	names, err := embeddedArchives()
+	if err != nil || len(names) == 0 {
+		return fmt.Errorf("list archives: %v", err)
+	}
+	if err := produceBlobsArchive(names, full, internal.BlobsArchive); err != nil {
+		return err
+	}
+	for _, l := range legacy {
+		fmt.Printf("texts of %s moved to %s\n", l, internal.BlobsArchive)
+		if err := os.Remove(l); err != nil {
+			return err
+		}
 	}
+	latest := names[len(names)-1]
+	version := archiveVersion(latest)
 
-	pkg := os.Getenv("GOPACKAGE")
-	src := fmt.Sprintf(template, pkg, *name)
+	embedNames := make([]string, len(names))
+	for i, n := range names {
+		embedNames[i] = filepath.ToSlash(n)
+	}
+	// Set by go generate
+	pkg := cmp.Or(os.Getenv("GOPACKAGE"), "internal")
+	if err := writeSource("embed_archive.go", fmt.Sprintf(
+		template, "!licensedb_notext && !licensedb_noembed", pkg,
+		strings.Join(append(embedNames, internal.BlobsArchive), " "), noTextDir, true, version,
+	)); err != nil {
+		return err
+	}
+	if err := writeSource("embed_notext.go", fmt.Sprintf(
+		template, "licensedb_notext && !licensedb_noembed", pkg, strings.Join(embedNames, " "), noTextDir, false, version,
+	)); err != nil {
+		return err
+	}
 
-	fmtSrc, err := format.Source([]byte(src))
+	idsSrc, err := writeIDs(latest, version)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "warning: gofmt failed: %w — writing unformatted source\n", err)
-		os.Exit(2)
+		return fmt.Errorf("generate IDs: %w", err)
 	}
+	return writeSource(idsFile, idsSrc)
+}
+
+// File with ID constants of root package
+const idsFile = "../ids.go"
 
-	if err := os.WriteFile("embed_archive.go", fmtSrc, 0o644); err != nil {
-		fatalf("write generated go file: %v", err)
+// idConstName returns Go identifier for SPDX ID, like "GPL_3_0_or_later"
+// for "GPL-3.0-or-later". IDs starting with digit get "ID_" prefix and
+// ones starting with lowercase letter are capitalised.
+func idConstName(id string) string {
+	name := strings.NewReplacer("-", "_", ".", "_", "+", "_plus").Replace(id)
+	switch r := name[0]; {
+	case r >= '0' && r <= '9':
+		name = "ID_" + name
+	case r >= 'a' && r <= 'z':
+		name = strings.ToUpper(name[:1]) + name[1:]
 	}
+	return name
 }
 
-func produceArchive(url, name string) error {
-	fmt.Println("downloading license archive")
-	b, err := downloadToMemory(url)
+// writeIDs returns source of root package file with ID constant for every
+// license and exception of archive.
+func writeIDs(archive, version string) (string, error) {
+	data, err := os.ReadFile(archive)
 	if err != nil {
-		return fmt.Errorf("download: %w", err)
+		return "", err
+	}
+	db, err := internal.NewZipDB(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", err
 	}
 
-	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	ids := make(map[string]*internal.Meta)
+	for _, file := range db.Filenames {
+		m, _ := db.GetMeta(file)
+		ids[m.ID] = m
+	}
+	var b strings.Builder
+	b.WriteString("// Code generated by genembed.go; DO NOT EDIT.\n\n")
+	b.WriteString("package licensedb\n\n")
+	fmt.Fprintf(&b, "// Licenses and exceptions of SPDX license list %s\n", version)
+	b.WriteString("const (\n")
+	consts := make(map[string]string)
+	for _, id := range slices.Sorted(maps.Keys(ids)) {
+		m := ids[id]
+		name := idConstName(id)
+		if other, ok := consts[name]; ok {
+			return "", fmt.Errorf("%s and %s have the same constant name %s", other, id, name)
+		}
+		consts[name] = id
+		if m.Name != "" {
+			fmt.Fprintf(&b, "\t// %s\n", m.Name)
+		}
+		if m.Deprecated {
+			if m.Name != "" {
+				b.WriteString("\t//\n")
+			}
+			b.WriteString("\t// Deprecated: ID is deprecated by SPDX.\n")
+		}
+		fmt.Fprintf(&b, "\t%s ID = %q\n", name, id)
+	}
+	b.WriteString(")\n")
+	return b.String(), nil
+}
+
+func writeSource(name, src string) error {
+	fmtSrc, err := format.Source([]byte(src))
 	if err != nil {
-		return fmt.Errorf("open zip: %w", err)
+		return fmt.Errorf("gofmt %s: %w", name, err)
+	}
+	if err := os.WriteFile(name, fmtSrc, 0o644); err != nil {
+		return fmt.Errorf("write generated go file: %w", err)
 	}
+	return nil
+}
 
-	// Create new zip in memory, collecting files from "text/" directory
-	outBuf := &bytes.Buffer{}
-	zw := zip.NewWriter(outBuf)
+// produceNoTextArchive copies archive src to dst without text blobs, so
+// only IDs and metadata are left.
+func produceNoTextArchive(src, dst string) error {
+	zr, err := zip.OpenReader(src)
+	if err != nil {
+		return fmt.Errorf("open zip: %w", err)
+	}
+	defer zr.Close()
 
+	extra := make(map[string][]byte)
 	for _, f := range zr.File {
-		n := f.Name
+		if !strings.HasPrefix(f.Name, "blobs/") {
+			data, err := readEntry(f)
+			if err != nil {
+				return err
+			}
+			extra[f.Name] = data
+		}
+	}
+	return writeArchive(dst, nil, nil, extra, internal.Manifest{})
+}
 
-		p := strings.Split(n, "/")
+// produceBlobsArchive writes archive dst with every text and template
+// blob referenced by archives, so texts shared by versions are stored
+// once. Blobs are taken from full archives and from dst itself.
+func produceBlobsArchive(archives, full []string, dst string) error {
+	wanted := make(map[string]string)
+	for _, name := range archives {
+		zr, err := zip.OpenReader(name)
+		if err != nil {
+			return fmt.Errorf("open zip: %w", err)
+		}
+		for _, indexName := range []string{"index.json", internal.TemplatesFile} {
+			var index map[string]string
+			f, err := zr.Open(indexName)
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			if err == nil {
+				err = json.NewDecoder(f).Decode(&index)
+				f.Close()
+			}
+			if err != nil {
+				zr.Close()
+				return fmt.Errorf("%s: %s: %w", name, indexName, err)
+			}
+			for _, hash := range index {
+				wanted["blobs/"+hash] = name
+			}
+		}
+		zr.Close()
+	}
 
-		// We only want entries under "<archive name>/text/"
-		if len(p) != 3 {
-			continue
+	blobs := make(map[string][]byte)
+	sources := full
+	if _, err := os.Stat(dst); err == nil {
+		sources = append([]string{dst}, full...)
+	}
+	for _, name := range sources {
+		zr, err := zip.OpenReader(name)
+		if err != nil {
+			return fmt.Errorf("open zip: %w", err)
 		}
-		if p[1] != "text" {
-			continue
+		for _, f := range zr.File {
+			if _, ok := wanted[f.Name]; !ok {
+				continue
+			}
+			if _, ok := blobs[f.Name]; ok {
+				continue
+			}
+			data, err := readEntry(f)
+			if err != nil {
+				zr.Close()
+				return err
+			}
+			blobs[f.Name] = data
+		}
+		zr.Close()
+	}
+	for blob, archive := range wanted {
+		if _, ok := blobs[blob]; !ok {
+			return fmt.Errorf("%s of %s is missing, regenerate it with -force", blob, archive)
+		}
+	}
+	return writeArchive(dst, nil, nil, blobs, internal.Manifest{})
+}
+
+// Source of license-list-data files
+type source struct {
+	name   string
+	sha256 string
+}
+
+// loadSource returns files of license-list-data release archive
+// downloaded from url or read from local path, which may also be
+// checkout directory. Only text/, template/ and json/ files are kept;
+// paths are relative to archive root, which may have single top-level
+// directory. If sum is set, archive must have this SHA-256; downloads
+// require it.
+func loadSource(url, src, sum string) (map[string][]byte, source, error) {
+	if info, err := os.Stat(src); err == nil && info.IsDir() {
+		if sum != "" {
+			return nil, source{}, fmt.Errorf("%s: -sha256 can't verify checkout directory", src)
 		}
+		files, err := readDir(src)
+		return files, source{name: filepath.Base(filepath.Clean(src))}, err
+	}
 
-		// compute new name: remove .txt suffix if present
-		newName := p[2]
-		newName = strings.TrimSuffix(newName, ".txt")
+	var (
+		data []byte
+		err  error
+		name string
+	)
+	if url != "" {
+		// Downloads must be pinned, so archive changes only with
+		// -sha256 in go:generate line
+		if sum == "" {
+			return nil, source{}, fmt.Errorf("%s: -sha256 is required with -url, use -src with downloaded archive to print it", url)
+		}
+		fmt.Println("downloading license archive")
+		data, err = downloadToMemory(url)
+		if err != nil {
+			return nil, source{}, fmt.Errorf("download: %w", err)
+		}
+		name = path.Base(url)
+	} else {
+		data, err = os.ReadFile(src)
+		if err != nil {
+			return nil, source{}, err
+		}
+		name = filepath.Base(src)
+	}
 
-		// prepare header using original file header as much as possible
-		rc, err := f.Open()
+	hash := sha256.Sum256(data)
+	got := hex.EncodeToString(hash[:])
+	if sum != "" && !strings.EqualFold(sum, got) {
+		return nil, source{}, fmt.Errorf("%s: SHA-256 is %s, want %s", name, got, sum)
+	}
+	fmt.Printf("%s SHA-256: %s\n", name, got)
+
+	var files map[string][]byte
+	switch {
+	case strings.HasSuffix(name, ".tar.gz") || strings.HasSuffix(name, ".tgz"):
+		zr, err := gzip.NewReader(bytes.NewReader(data))
 		if err != nil {
-			return fmt.Errorf("open entry %s: %w", f.Name, err)
+			return nil, source{}, fmt.Errorf("%s: %w", name, err)
+		}
+		files, err = readTar(zr)
+	case strings.HasSuffix(name, ".tar"):
+		files, err = readTar(bytes.NewReader(data))
+	default:
+		files, err = readZip(data)
+	}
+	if err != nil {
+		return nil, source{}, fmt.Errorf("%s: %w", name, err)
+	}
+	return files, source{name, got}, nil
+}
+
+// wanted reports whether file at path is needed to produce archive
+func wanted(p string) bool {
+	_, _, ok := mapEntry(p)
+	return ok
+}
+
+func readZip(data []byte) (map[string][]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("open zip: %w", err)
+	}
+	files := make(map[string][]byte)
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || !wanted(f.Name) {
+			continue
+		}
+		if files[f.Name], err = readEntry(f); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+func readTar(r io.Reader) (map[string][]byte, error) {
+	tr := tar.NewReader(r)
+	files := make(map[string][]byte)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files, nil
 		}
-		data, err := io.ReadAll(rc)
-		rc.Close()
 		if err != nil {
-			return fmt.Errorf("read entry %s: %w", f.Name, err)
+			return nil, err
+		}
+		if h.Typeflag != tar.TypeReg || !wanted(h.Name) {
+			continue
+		}
+		if files[h.Name], err = io.ReadAll(tr); err != nil {
+			return nil, fmt.Errorf("read entry %s: %w", h.Name, err)
+		}
+	}
+}
+
+func readDir(dir string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	fsys := os.DirFS(dir)
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !wanted(p) {
+			return err
+		}
+		files[p], err = fs.ReadFile(fsys, p)
+		return err
+	})
+	return files, err
+}
+
+// Kinds of license-list-data files kept in produced archive
+type entryKind int
+
+const (
+	extraEntry entryKind = iota
+	textEntry
+	templateEntry
+	// Details files, reduced before storing
+	detailsEntry
+)
+
+// mapEntry returns name of license-list-data file in produced archive
+// and its kind.
+func mapEntry(name string) (newName string, kind entryKind, ok bool) {
+	p := strings.Split(name, "/")
+	// Release archives have single top-level directory
+	if len(p) > 0 && p[0] != "text" && p[0] != "template" && p[0] != "json" {
+		p = p[1:]
+	}
+	switch {
+	// "text/<ID>.txt" -> "<ID>"
+	case len(p) == 2 && p[0] == "text" && strings.HasSuffix(p[1], ".txt"):
+		return strings.TrimSuffix(p[1], ".txt"), textEntry, true
+	// "template/<ID>.template.txt" -> "<ID>"
+	case len(p) == 2 && p[0] == "template" && strings.HasSuffix(p[1], ".template.txt"):
+		return strings.TrimSuffix(p[1], ".template.txt"), templateEntry, true
+	// "json/licenses.json" -> "json/licenses.json"
+	case len(p) == 2 && p[0] == "json" &&
+		(p[1] == "licenses.json" || p[1] == "exceptions.json"):
+		return "json/" + p[1], extraEntry, true
+	// "json/details/<ID>.json" -> "json/details/<ID>.json"
+	case len(p) == 3 && p[0] == "json" &&
+		(p[1] == "details" || p[1] == "exceptions") &&
+		strings.HasSuffix(p[2], ".json"):
+		return "json/" + p[1] + "/" + p[2], detailsEntry, true
+	}
+	return "", extraEntry, false
+}
+
+// produceArchive writes archive name from license-list-data files.
+// Output depends only on contents of files, not on their order or
+// timestamps.
+func produceArchive(files map[string][]byte, src source, name string) error {
+	// Collect files from "text/" and "template/" directories and
+	// license list metadata from "json/" directory
+	texts := make(map[string][]byte)
+	templates := make(map[string][]byte)
+	extra := make(map[string][]byte)
+
+	for n, data := range files {
+		newName, kind, ok := mapEntry(n)
+		if !ok || newName == "" {
+			continue
 		}
 
-		h := &zip.FileHeader{
-			Name:   newName,
-			Method: zip.Deflate,
+		switch kind {
+		case textEntry:
+			texts[newName] = data
+		case templateEntry:
+			templates[newName] = data
+		case detailsEntry:
+			data, err := reduceDetails(data)
+			if err != nil {
+				return fmt.Errorf("reduce entry %s: %w", n, err)
+			}
+			if data != nil {
+				extra[newName] = data
+			}
+		default:
+			extra[newName] = data
 		}
+	}
+	if len(texts) == 0 {
+		return fmt.Errorf("%s: no license texts found", src.name)
+	}
+
+	return writeArchive(name, texts, templates, extra, internal.Manifest{
+		ListVersion:  listVersion(extra, name),
+		Source:       src.name,
+		SourceSHA256: src.sha256,
+	})
+}
+
+// listVersion returns license list version from metadata or from name of
+// archive like spdx3.27.0.zip.
+func listVersion(extra map[string][]byte, name string) string {
+	var list struct {
+		Version string `json:"licenseListVersion"`
+	}
+	if json.Unmarshal(extra["json/licenses.json"], &list) == nil && list.Version != "" {
+		return list.Version
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(filepath.Base(name), "spdx"), ".zip")
+}
 
-		w, err := zw.CreateHeader(h)
+func readEntry(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("open entry %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("read entry %s: %w", f.Name, err)
+	}
+	return data, nil
+}
+
+// addBlobs stores each of data as "blobs/<sha256>" in blobs and returns
+// index mapping names to hashes.
+func addBlobs(blobs, data map[string][]byte) map[string]string {
+	index := make(map[string]string, len(data))
+	for id, d := range data {
+		sum := sha256.Sum256(d)
+		hash := hex.EncodeToString(sum[:])
+		index[id] = hash
+		blobs["blobs/"+hash] = d
+	}
+	return index
+}
+
+// writeArchive writes zip archive with each unique text and template
+// stored once as "blobs/<sha256>", "index.json" and "templates.json"
+// mapping file names to hashes, forms derived from file names, IDs of
+// exceptions, manifest and extra files as is. Without texts only extra files are written.
+func writeArchive(name string, texts, templates, extra map[string][]byte, manifest internal.Manifest) error {
+	blobs := make(map[string][]byte)
+	index := addBlobs(blobs, texts)
+	manifest.Texts = len(blobs)
+	files := maps.Clone(extra)
+	if texts != nil {
+		indexData, err := json.Marshal(index)
+		if err != nil {
+			return err
+		}
+		files["index.json"] = indexData
+		if len(templates) > 0 {
+			templatesData, err := json.Marshal(addBlobs(blobs, templates))
+			if err != nil {
+				return err
+			}
+			files[internal.TemplatesFile] = templatesData
+			manifest.Templates = len(templates)
+		}
+		maps.Copy(files, blobs)
+		formsData, err := json.Marshal(internal.ComputeForms(slices.Sorted(maps.Keys(index))))
 		if err != nil {
-			fatalf("create header %s: %v", newName, err)
-			return fmt.Errorf("create header %s: %w", newName, err)
+			return err
 		}
-		if _, err := w.Write(data); err != nil {
-			return fmt.Errorf("write data %s: %w", newName, err)
+		files[internal.FormsFile] = formsData
+		if ids, ok, err := exceptionIDs(extra); err != nil {
+			return err
+		} else if ok {
+			files[internal.ExceptionsFile] = ids
 		}
+		manifest.Files = len(index)
+		manifestData, err := json.MarshalIndent(manifest, "", "  ")
+		if err != nil {
+			return err
+		}
+		files[internal.ManifestFile] = manifestData
 	}
 
+	outBuf := &bytes.Buffer{}
+	zw := zip.NewWriter(outBuf)
+	// Sorted names keep archive reproducible
+	for _, n := range slices.Sorted(maps.Keys(files)) {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: n, Method: zip.Deflate})
+		if err != nil {
+			return fmt.Errorf("create header %s: %w", n, err)
+		}
+		if _, err := w.Write(files[n]); err != nil {
+			return fmt.Errorf("write data %s: %w", n, err)
+		}
+	}
 	if err := zw.Close(); err != nil {
-		fatalf("close new zip: %v", err)
 		return fmt.Errorf("close new zip: %w", err)
 	}
 
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
 	if err := os.WriteFile(name, outBuf.Bytes(), 0o644); err != nil {
-		fatalf("write output zip %s: %v", name, err)
 		return fmt.Errorf("write output zip %s: %w", name, err)
 	}
-
 	return nil
 }
 
+// exceptionIDs returns content of internal.ExceptionsFile derived from
+// json/exceptions.json of extra. Reports false if there is no such file.
+func exceptionIDs(extra map[string][]byte) ([]byte, bool, error) {
+	data, ok := extra["json/exceptions.json"]
+	if !ok {
+		return nil, false, nil
+	}
+	var list struct {
+		Exceptions []struct {
+			ID string `json:"licenseExceptionId"`
+		} `json:"exceptions"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, false, fmt.Errorf("json/exceptions.json: %w", err)
+	}
+	ids := make([]string, len(list.Exceptions))
+	for i, e := range list.Exceptions {
+		ids[i] = e.ID
+	}
+	slices.Sort(ids)
+	data, err := json.Marshal(ids)
+	return data, true, err
+}
+
+// upgradeArchive rewrites archive generated by older genembed.go, which
+// stored every text as "<ID>" entry or had no precomputed forms,
+// exception IDs or manifest, in format of writeArchive.
+func upgradeArchive(name string) error {
+	zr, err := zip.OpenReader(name)
+	if err != nil {
+		return fmt.Errorf("open zip: %w", err)
+	}
+	defer zr.Close()
+
+	files := make(map[string][]byte)
+	for _, f := range zr.File {
+		if f.Name == "" || strings.HasSuffix(f.Name, "/") {
+			continue
+		}
+		data, err := readEntry(f)
+		if err != nil {
+			return err
+		}
+		files[f.Name] = data
+	}
+	_, indexed := files["index.json"]
+	_, hasForms := files[internal.FormsFile]
+	_, hasManifest := files[internal.ManifestFile]
+	_, hasExceptions := files[internal.ExceptionsFile]
+	_, hasExceptionsMeta := files["json/exceptions.json"]
+	if indexed && hasForms && hasManifest && (hasExceptions || !hasExceptionsMeta) {
+		return nil
+	}
+	manifest := internal.Manifest{ListVersion: listVersion(files, name)}
+	if hasManifest {
+		if err := json.Unmarshal(files[internal.ManifestFile], &manifest); err != nil {
+			return fmt.Errorf("%s: %w", internal.ManifestFile, err)
+		}
+	}
+
+	texts := make(map[string][]byte)
+	templates := make(map[string][]byte)
+	extra := make(map[string][]byte)
+	for indexName, m := range map[string]map[string][]byte{
+		"index.json":           texts,
+		internal.TemplatesFile: templates,
+	} {
+		if _, ok := files[indexName]; !ok {
+			continue
+		}
+		var index map[string]string
+		if err := json.Unmarshal(files[indexName], &index); err != nil {
+			return fmt.Errorf("%s: %w", indexName, err)
+		}
+		for id, hash := range index {
+			m[id] = files["blobs/"+hash]
+		}
+	}
+	for n, data := range files {
+		switch {
+		case strings.HasPrefix(n, "json/") || n == internal.ExceptionsFile:
+			extra[n] = data
+		case !indexed && !strings.Contains(n, "/"):
+			texts[n] = data
+		}
+	}
+	fmt.Printf("upgrading %s\n", name)
+	return writeArchive(name, texts, templates, extra, manifest)
+}
+
+// Fields of per-license details files that are not already present in
+// licenses.json/exceptions.json or text files
+type details struct {
+	LicenseComments       string `json:"licenseComments,omitempty"`
+	StandardLicenseHeader string `json:"standardLicenseHeader,omitempty"`
+}
+
+// reduceDetails strips details file down to fields missing from the lists.
+// Returns nil if nothing is left.
+func reduceDetails(data []byte) ([]byte, error) {
+	var d details
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+	if d == (details{}) {
+		return nil, nil
+	}
+	return json.Marshal(d)
+}
+
 // downloadToMemory GETs url and returns body as []byte
 func downloadToMemory(url string) ([]byte, error) {
 	resp, err := http.Get(url)
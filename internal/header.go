@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Placeholders of copyright year and holder in standard license headers,
+// like "[yyyy]" and "<name of author>"
+var (
+	yearPlaceholderRe   = regexp.MustCompile(`(?i)[<\[][^<>\[\]]*\b(?:year|yyyy)\b[^<>\[\]]*[>\]]`)
+	holderPlaceholderRe = regexp.MustCompile(`(?i)[<\[][^<>\[\]]*\b(?:owners?|holders?|authors?)\b[^<>\[\]]*[>\]]`)
+)
+
+// FillHeader replaces placeholders of copyright year and holder in
+// standard license header. Empty values keep placeholders as is.
+func FillHeader(header, year, holder string) string {
+	if year != "" {
+		header = yearPlaceholderRe.ReplaceAllLiteralString(header, year)
+	}
+	if holder != "" {
+		header = holderPlaceholderRe.ReplaceAllLiteralString(header, holder)
+	}
+	return header
+}
+
+// WrapText splits text into lines of at most width runes, unless a word
+// is longer. Lines of paragraph are joined before wrapping; empty lines
+// and lines starting with whitespace, like indented URLs, are kept as is.
+// Non-positive width disables wrapping.
+func WrapText(text string, width int) []string {
+	var lines, paragraph []string
+	flush := func() {
+		if len(paragraph) == 0 {
+			return
+		}
+		if width <= 0 {
+			lines = append(lines, strings.Join(paragraph, " "))
+			paragraph = paragraph[:0]
+			return
+		}
+		line, n := "", 0
+		for _, word := range paragraph {
+			wn := utf8.RuneCountInString(word)
+			if n > 0 && n+1+wn > width {
+				lines = append(lines, line)
+				line, n = "", 0
+			}
+			if n > 0 {
+				line += " "
+				n++
+			}
+			line += word
+			n += wn
+		}
+		lines = append(lines, line)
+		paragraph = paragraph[:0]
+	}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRightFunc(line, unicode.IsSpace)
+		if line == "" || strings.TrimLeftFunc(line, unicode.IsSpace) != line {
+			flush()
+			lines = append(lines, line)
+			continue
+		}
+		paragraph = append(paragraph, strings.Fields(line)...)
+	}
+	flush()
+	return lines
+}
+
+// Encoding declarations of Python and Emacs, like "# -*- coding: utf-8 -*-"
+var codingRe = regexp.MustCompile(`^[ \t\f]*#.*?coding[:=]`)
+
+// isPreambleLine reports whether line must stay at the top of the file:
+// shebang, Go build constraint, XML declaration or encoding declaration.
+func isPreambleLine(line string) bool {
+	return strings.HasPrefix(line, "#!") ||
+		strings.HasPrefix(line, "//go:build") ||
+		strings.HasPrefix(line, "// +build") ||
+		strings.HasPrefix(line, "<?xml") ||
+		codingRe.MatchString(line)
+}
+
+// InsertHeader returns src with comment put at the top, after shebang,
+// build constraints and other lines that must stay first. Header is
+// separated from the code around it with blank lines.
+func InsertHeader(src, comment string) string {
+	var b strings.Builder
+	rest := src
+	for rest != "" {
+		line, tail, _ := strings.Cut(rest, "\n")
+		if !isPreambleLine(line) {
+			break
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+		rest = tail
+	}
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+	b.WriteString(strings.TrimRight(comment, "\n"))
+	b.WriteString("\n")
+	// Drop blank lines that separated preamble from code
+	for rest != "" {
+		line, tail, _ := strings.Cut(rest, "\n")
+		if strings.TrimSpace(line) != "" {
+			break
+		}
+		rest = tail
+	}
+	if rest != "" {
+		b.WriteString("\n")
+		b.WriteString(rest)
+	}
+	return b.String()
+}
@@ -0,0 +1,84 @@
+package internal_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+func Test_FillHeader(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		header, year, holder string
+		want                 string
+	}{
+		{"Copyright [yyyy] [name of copyright owner]", "2024", "Jane Doe", "Copyright 2024 Jane Doe"},
+		{"Copyright (C) <year>  <name of author>", "2020-2024", "Bob", "Copyright (C) 2020-2024  Bob"},
+		{"Copyright (c) <YEAR> <copyright holders>", "2024", "", "Copyright (c) 2024 <copyright holders>"},
+		{"<one line to give the program's name>\nCopyright <year>", "2024", "Bob", "<one line to give the program's name>\nCopyright 2024"},
+		{"Copyright [yyyy] [name of copyright owner]", "", "", "Copyright [yyyy] [name of copyright owner]"},
+	}
+	for _, c := range cases {
+		if got := internal.FillHeader(c.header, c.year, c.holder); got != c.want {
+			t.Fatalf("FillHeader(%q, %q, %q) = %q; want %q", c.header, c.year, c.holder, got, c.want)
+		}
+	}
+}
+
+func Test_WrapText(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name  string
+		text  string
+		width int
+		want  []string
+	}{
+		{"short", "a b c", 10, []string{"a b c"}},
+		{"wrapped", "aaa bbb ccc\nddd", 7, []string{"aaa bbb", "ccc ddd"}},
+		{"long word", "a verylongword b", 5, []string{"a", "verylongword", "b"}},
+		{"paragraphs", "a\nb\n\nc", 10, []string{"a b", "", "c"}},
+		{"indented", "see\n   http://example.com\nfor more", 80, []string{"see", "   http://example.com", "for more"}},
+		{"no wrap", "aaa bbb\nccc", 0, []string{"aaa bbb ccc"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			if got := internal.WrapText(c.text, c.width); !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("WrapText(%q, %d) = %q; want %q", c.text, c.width, got, c.want)
+			}
+		})
+	}
+}
+
+func Test_InsertHeader(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"empty", "", "# H\n"},
+		{"plain", "code\n", "# H\n\ncode\n"},
+		{"shebang", "#!/bin/sh\necho\n", "#!/bin/sh\n\n# H\n\necho\n"},
+		{"shebang only", "#!/bin/sh", "#!/bin/sh\n\n# H\n"},
+		{
+			"build constraints",
+			"//go:build linux\n// +build linux\n\npackage main\n",
+			"//go:build linux\n// +build linux\n\n# H\n\npackage main\n",
+		},
+		{
+			"encoding",
+			"#!/usr/bin/env python\n# -*- coding: utf-8 -*-\nimport os\n",
+			"#!/usr/bin/env python\n# -*- coding: utf-8 -*-\n\n# H\n\nimport os\n",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			if got := internal.InsertHeader(c.src, "# H\n"); got != c.want {
+				t.Fatalf("InsertHeader(%q) = %q; want %q", c.src, got, c.want)
+			}
+		})
+	}
+}
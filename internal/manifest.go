@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path"
+)
+
+// File with Manifest in archives produced by genembed.go
+const ManifestFile = "manifest.json"
+
+// Manifest describes archive produced by genembed.go
+type Manifest struct {
+	// Version of SPDX license list
+	ListVersion string `json:"licenseListVersion"`
+	// Base name of URL or path archive was generated from.
+	// Empty for archives upgraded from older genembed.go format.
+	Source string `json:"source,omitempty"`
+	// SHA-256 of source archive, empty for checkouts
+	SourceSHA256 string `json:"sourceSHA256,omitempty"`
+	// Number of text files and of unique texts among them
+	Files int `json:"files"`
+	Texts int `json:"texts"`
+	// Number of license templates
+	Templates int `json:"templates,omitempty"`
+	// SHA-256 of text of each file. It isn't stored in manifest file,
+	// but is taken from index.
+	Hashes map[string]string `json:"-"`
+}
+
+// loadManifest reads ManifestFile from fsys. Hashes are taken from
+// Files of content-addressed db.
+func (db *DB) loadManifest() error {
+	data, err := fs.ReadFile(db.fsys, ManifestFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	m := &Manifest{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return fmt.Errorf("%s: %w", ManifestFile, err)
+	}
+	if db.contentAddressed {
+		m.Hashes = make(map[string]string, len(db.Files))
+		for name, p := range db.Files {
+			m.Hashes[name] = path.Base(p)
+		}
+	}
+	db.manifest = m
+	return nil
+}
+
+// Manifest returns manifest of archive db was built from, if there is one.
+func (db *DB) Manifest() (Manifest, bool) {
+	if db.manifest == nil {
+		return Manifest{}, false
+	}
+	m := *db.manifest
+	m.Hashes = maps.Clone(m.Hashes)
+	return m, true
+}
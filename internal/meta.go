@@ -0,0 +1,175 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// License or exception metadata from SPDX license list
+type Meta struct {
+	ID          string
+	Name        string
+	IsException bool
+	OSIApproved bool
+	FSFLibre    bool
+	Deprecated  bool
+	SeeAlso     []string
+	Comment     string
+	// Standard header to put in source files, with placeholders like
+	// "[yyyy]". Empty for licenses without one.
+	Header string
+	// Licenses the exception is used with, only for exceptions
+	RelatedLicenses []string
+}
+
+// Layout shared by json/licenses.json and json/exceptions.json
+type licenseList struct {
+	Version  string `json:"licenseListVersion"`
+	Licenses []struct {
+		ID         string   `json:"licenseId"`
+		Name       string   `json:"name"`
+		Deprecated bool     `json:"isDeprecatedLicenseId"`
+		OSI        bool     `json:"isOsiApproved"`
+		FSF        bool     `json:"isFsfLibre"`
+		SeeAlso    []string `json:"seeAlso"`
+	} `json:"licenses"`
+	Exceptions []struct {
+		ID         string   `json:"licenseExceptionId"`
+		Name       string   `json:"name"`
+		Deprecated bool     `json:"isDeprecatedLicenseId"`
+		SeeAlso    []string `json:"seeAlso"`
+	} `json:"exceptions"`
+}
+
+// Layout of json/details/<ID>.json and json/exceptions/<ID>.json
+type licenseDetails struct {
+	Comment string `json:"licenseComments"`
+	Header  string `json:"standardLicenseHeader"`
+}
+
+// readJSON decodes file at name in fsys into v.
+// Reports false without error if there is no such file.
+func readJSON(fsys fs.FS, name string, v any) (bool, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, &fs.PathError{Op: "decode", Path: name, Err: err}
+	}
+	return true, nil
+}
+
+// LoadMeta reads license list metadata from json/ directory of fsys.
+// Missing files are not an error, they just leave fields empty.
+func LoadMeta(fsys fs.FS) (map[string]*Meta, error) {
+	meta, _, err := loadMeta(fsys)
+	return meta, err
+}
+
+// loadMeta is LoadMeta that also returns license list version.
+func loadMeta(fsys fs.FS) (map[string]*Meta, string, error) {
+	meta := make(map[string]*Meta)
+	var list licenseList
+	if _, err := readJSON(fsys, "json/licenses.json", &list); err != nil {
+		return nil, "", err
+	}
+	if _, err := readJSON(fsys, "json/exceptions.json", &list); err != nil {
+		return nil, "", err
+	}
+	for _, l := range list.Licenses {
+		meta[l.ID] = &Meta{
+			ID:          l.ID,
+			Name:        l.Name,
+			OSIApproved: l.OSI,
+			FSFLibre:    l.FSF,
+			Deprecated:  l.Deprecated,
+			SeeAlso:     l.SeeAlso,
+		}
+	}
+	for _, e := range list.Exceptions {
+		meta[e.ID] = &Meta{
+			ID:          e.ID,
+			Name:        e.Name,
+			IsException: true,
+			Deprecated:  e.Deprecated,
+			SeeAlso:     e.SeeAlso,
+		}
+	}
+	for id, m := range meta {
+		dir := "json/details"
+		if m.IsException {
+			dir = "json/exceptions"
+		}
+		var d licenseDetails
+		if _, err := readJSON(fsys, path.Join(dir, id+".json"), &d); err != nil {
+			return nil, "", err
+		}
+		m.Comment = d.Comment
+		m.Header = d.Header
+	}
+	return meta, list.Version, nil
+}
+
+// HasMetadata reports whether license list metadata was found in json/.
+func (db *DB) HasMetadata() bool {
+	return len(db.Metadata) > 0
+}
+
+// ResolveFile returns archive file name for SPDX ID or any of its
+// alternative forms.
+func (db *DB) ResolveFile(id string) (string, bool) {
+	for _, candidate := range []string{id, db.TokenToCanonical(id)} {
+		if _, ok := db.Files[candidate]; ok {
+			return candidate, true
+		}
+		if _, ok := db.Files["deprecated_"+candidate]; ok {
+			return "deprecated_" + candidate, true
+		}
+	}
+	return "", false
+}
+
+// GetMeta returns metadata for SPDX ID or any of its alternative forms.
+// For archives without metadata only ID, IsException, Deprecated and
+// RelatedLicenses fields are filled.
+func (db *DB) GetMeta(id string) (*Meta, bool) {
+	file, ok := db.ResolveFile(id)
+	if !ok {
+		return nil, false
+	}
+	clean := strings.TrimPrefix(file, "deprecated_")
+	if m, ok := db.Metadata[clean]; ok {
+		return m, true
+	}
+	return &Meta{
+		ID:              clean,
+		IsException:     db.IsException(clean),
+		Deprecated:      clean != file,
+		RelatedLicenses: db.RelatedLicenses[clean],
+	}, true
+}
+
+// Family of license IDs (like "BSD" for "BSD-3-Clause" and "0BSD")
+// that can't be derived from ID prefix
+var FamilyOverrides = map[string]string{
+	"0BSD": "BSD",
+}
+
+// Family returns name of license family id belongs to.
+// It is the part of ID before first hyphen unless overridden.
+// Example: "GPL-3.0-or-later" -> "GPL"
+func Family(id string) string {
+	id = strings.TrimPrefix(id, "deprecated_")
+	if family, ok := FamilyOverrides[id]; ok {
+		return family
+	}
+	family, _, _ := strings.Cut(id, "-")
+	return family
+}
@@ -0,0 +1,82 @@
+package internal_test
+
+import (
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+func Test_LoadMeta(t *testing.T) {
+	fsys := fstest.MapFS{
+		"json/licenses.json": {Data: []byte(`{
+			"licenseListVersion": "3.27",
+			"licenses": [{
+				"licenseId": "MIT",
+				"name": "MIT License",
+				"isDeprecatedLicenseId": false,
+				"isOsiApproved": true,
+				"isFsfLibre": true,
+				"seeAlso": ["https://opensource.org/license/mit/"]
+			}, {
+				"licenseId": "GPL-2.0",
+				"name": "GNU General Public License v2.0 only",
+				"isDeprecatedLicenseId": true,
+				"isOsiApproved": true,
+				"seeAlso": []
+			}]
+		}`)},
+		"json/exceptions.json": {Data: []byte(`{
+			"licenseListVersion": "3.27",
+			"exceptions": [{
+				"licenseExceptionId": "LLVM-exception",
+				"name": "LLVM Exception",
+				"isDeprecatedLicenseId": false,
+				"seeAlso": ["https://llvm.org/foundation/relicensing/LICENSE.txt"]
+			}]
+		}`)},
+		"json/details/MIT.json": {Data: []byte(`{"licenseComments": "Also known as Expat"}`)},
+	}
+
+	got, err := internal.LoadMeta(fsys)
+	if err != nil {
+		t.Fatalf("LoadMeta() error: %v", err)
+	}
+	want := map[string]*internal.Meta{
+		"MIT": {
+			ID:          "MIT",
+			Name:        "MIT License",
+			OSIApproved: true,
+			FSFLibre:    true,
+			SeeAlso:     []string{"https://opensource.org/license/mit/"},
+			Comment:     "Also known as Expat",
+		},
+		"GPL-2.0": {
+			ID:          "GPL-2.0",
+			Name:        "GNU General Public License v2.0 only",
+			OSIApproved: true,
+			Deprecated:  true,
+			SeeAlso:     []string{},
+		},
+		"LLVM-exception": {
+			ID:          "LLVM-exception",
+			Name:        "LLVM Exception",
+			IsException: true,
+			SeeAlso:     []string{"https://llvm.org/foundation/relicensing/LICENSE.txt"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("LoadMeta() = %v; want %v", got, want)
+	}
+
+	empty, err := internal.LoadMeta(fstest.MapFS{})
+	if err != nil || len(empty) != 0 {
+		t.Fatalf("LoadMeta(empty) = %v, %v; want empty map", empty, err)
+	}
+
+	broken := fstest.MapFS{"json/licenses.json": {Data: []byte(`{`)}}
+	if _, err := internal.LoadMeta(broken); err == nil {
+		t.Fatalf("LoadMeta(broken) succeeded; want error")
+	}
+}
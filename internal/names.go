@@ -0,0 +1,227 @@
+package internal
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Candidates for names that only partially match known ones are not
+// returned below this score
+const MinNameScore = 0.5
+
+// Widely used license names that differ from official SPDX ones,
+// in NormaliseName form
+var CommonNames = map[string]string{
+	"apache 2":                              "Apache-2.0",
+	"apache license 2":                      "Apache-2.0",
+	"apache software license 2":             "Apache-2.0",
+	"asf 2":                                 "Apache-2.0",
+	"mit license":                           "MIT",
+	"mit":                                   "MIT",
+	"expat license":                         "MIT",
+	"isc license":                           "ISC",
+	"new bsd license":                       "BSD-3-Clause",
+	"modified bsd license":                  "BSD-3-Clause",
+	"revised bsd license":                   "BSD-3-Clause",
+	"bsd 3 clause license":                  "BSD-3-Clause",
+	"simplified bsd license":                "BSD-2-Clause",
+	"bsd 2 clause license":                  "BSD-2-Clause",
+	"gnu general public license 2":          "GPL-2.0-only",
+	"gnu general public license 3":          "GPL-3.0-only",
+	"gnu gpl 2":                             "GPL-2.0-only",
+	"gnu gpl 3":                             "GPL-3.0-only",
+	"gnu library general public license 2":  "LGPL-2.0-only",
+	"gnu lesser general public license 2.1": "LGPL-2.1-only",
+	"gnu lesser general public license 3":   "LGPL-3.0-only",
+	"gnu lgpl 2.1":                          "LGPL-2.1-only",
+	"gnu lgpl 3":                            "LGPL-3.0-only",
+	"gnu affero general public license 3":   "AGPL-3.0-only",
+	"mozilla public license 2":              "MPL-2.0",
+	"eclipse public license 1":              "EPL-1.0",
+	"eclipse public license 2":              "EPL-2.0",
+	"common development and distribution license 1": "CDDL-1.0",
+	"boost software license 1":                      "BSL-1.0",
+	"unlicense":                                     "Unlicense",
+	"zlib license":                                  "Zlib",
+	"python software foundation license":            "PSF-2.0",
+	"european union public license 1.2":             "EUPL-1.2",
+	"artistic license 2":                            "Artistic-2.0",
+	"cc0 1 universal":                               "CC0-1.0",
+}
+
+// Common names of licenses that differ from the SPDX one they map to,
+// like FreeBSD License, which is BSD-2-Clause with a "views" clause
+// (BSD-2-Clause-Views). Candidates for them get LossyNameScore.
+var LossyNames = map[string]string{
+	"freebsd license": "BSD-2-Clause",
+}
+
+// Score of candidates for LossyNames
+const LossyNameScore = 0.7
+
+// NormaliseName lowercases license name and strips noise words,
+// punctuation and version markers, so that for example
+// "The Apache License, Version 2.0" becomes "apache license 2".
+func NormaliseName(name string) string {
+	name = strings.ToLower(name)
+	name = strings.ReplaceAll(name, "licence", "license")
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		case r == '.' && i > 0 && i+1 < len(runes) &&
+			unicode.IsDigit(runes[i-1]) && unicode.IsDigit(runes[i+1]):
+			b.WriteRune(r)
+		case r == '+':
+			b.WriteString(" or later ")
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	words := strings.Fields(b.String())
+	out := make([]string, 0, len(words))
+	for i, w := range words {
+		switch {
+		case w == "the" || w == "version" || w == "ver":
+			continue
+		// "v. 2.0" -> "2.0"
+		case w == "v" && i+1 < len(words) && isVersion(words[i+1]):
+			continue
+		// "or any later" -> "or later"
+		case w == "any" && i > 0 && words[i-1] == "or" &&
+			i+1 < len(words) && words[i+1] == "later":
+			continue
+		case len(w) > 1 && w[0] == 'v' && isVersion(w[1:]):
+			w = w[1:]
+		}
+		if isVersion(w) {
+			for strings.HasSuffix(w, ".0") {
+				w = strings.TrimSuffix(w, ".0")
+			}
+		}
+		out = append(out, w)
+	}
+	return strings.Join(out, " ")
+}
+
+func isVersion(s string) bool {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return false
+	}
+	for _, r := range s {
+		if (r < '0' || r > '9') && r != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+type nameEntry struct {
+	key   string
+	words []string
+	id    string
+	score float64
+}
+
+// Index of normalised license names
+type NameIndex struct {
+	entries []nameEntry
+	// Known IDs, to check which -or-later variants exist
+	ids map[string]bool
+}
+
+func (idx *NameIndex) add(name, id string, score float64) {
+	key := NormaliseName(name)
+	if key == "" {
+		return
+	}
+	idx.entries = append(idx.entries, nameEntry{key, strings.Fields(key), id, score})
+}
+
+// NewNameIndex indexes official names from meta, their variants without
+// "only" suffix and CommonNames. IDs of db are known to index too unless
+// it is nil.
+func NewNameIndex(db *DB, meta map[string]*Meta) *NameIndex {
+	idx := &NameIndex{ids: make(map[string]bool)}
+	for id := range meta {
+		idx.ids[id] = true
+	}
+	if db != nil {
+		for _, file := range db.Filenames {
+			idx.ids[file] = true
+		}
+	}
+	for id, m := range meta {
+		if m.Deprecated || m.Name == "" {
+			continue
+		}
+		idx.add(m.Name, id, 1)
+		idx.add(id, id, 1)
+		if key := NormaliseName(m.Name); strings.HasSuffix(key, " only") {
+			idx.add(strings.TrimSuffix(key, " only"), id, 0.95)
+		}
+	}
+	for name, id := range CommonNames {
+		idx.add(name, id, 0.95)
+	}
+	for name, id := range LossyNames {
+		idx.add(name, id, LossyNameScore)
+	}
+	return idx
+}
+
+// orLater returns -or-later variant of -only ID if there is one.
+func (idx *NameIndex) orLater(id string) (string, bool) {
+	base, ok := strings.CutSuffix(id, "-only")
+	if !ok {
+		return "", false
+	}
+	if !idx.ids[base+"-or-later"] {
+		return "", false
+	}
+	return base + "-or-later", true
+}
+
+// Match returns candidate IDs for license name, best first.
+func (idx *NameIndex) Match(name string) []Candidate {
+	key := NormaliseName(name)
+	base, later := strings.CutSuffix(key, " or later")
+	words := strings.Fields(key)
+	baseWords := strings.Fields(base)
+	best := make(map[string]float64)
+	add := func(id string, score float64) {
+		if score >= MinNameScore && score > best[id] {
+			best[id] = score
+		}
+	}
+	for _, e := range idx.entries {
+		switch {
+		case e.key == key:
+			add(e.id, e.score)
+		case later && e.key == base:
+			if id, ok := idx.orLater(e.id); ok {
+				add(id, e.score*0.95)
+			}
+		default:
+			// Partial matches never outrank exact ones
+			add(e.id, 0.9*e.score*wordsSimilarity(words, e.words))
+			if id, ok := idx.orLater(e.id); ok && later {
+				add(id, 0.9*e.score*wordsSimilarity(baseWords, e.words))
+			}
+		}
+	}
+	candidates := make([]Candidate, 0, len(best))
+	for id, score := range best {
+		candidates = append(candidates, Candidate{id, score})
+	}
+	sortCandidates(candidates)
+	return candidates
+}
+
+// FromName returns candidate IDs for full license name like
+// "GNU Lesser General Public License v2.1 or later", best first.
+func (db *DB) FromName(name string) []Candidate {
+	return db.names().Match(name)
+}
@@ -0,0 +1,76 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+func Test_NormaliseName(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"The Apache License, Version 2.0", "apache license 2"},
+		{"Apache License 2.0", "apache license 2"},
+		{"GNU Lesser General Public License v2.1 or later", "gnu lesser general public license 2.1 or later"},
+		{"GNU GPL v3 or any later version", "gnu gpl 3 or later"},
+		{"Mozilla Public Licence, v. 2.0", "mozilla public license 2"},
+		{"BSD 3-Clause \"New\" or \"Revised\" License", "bsd 3 clause new or revised license"},
+		{"Blue Oak Model License 1.0.0", "blue oak model license 1"},
+		{"LGPL 2.1+", "lgpl 2.1 or later"},
+		{"", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			got := internal.NormaliseName(tc.in)
+			if got != tc.want {
+				t.Fatalf("NormaliseName(%v) = %v; want %v", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func Test_NameIndexMatch(t *testing.T) {
+	idx := internal.NewNameIndex(nil, map[string]*internal.Meta{
+		"GPL-2.0-only":     {ID: "GPL-2.0-only", Name: "GNU General Public License v2.0 only"},
+		"GPL-2.0-or-later": {ID: "GPL-2.0-or-later", Name: "GNU General Public License v2.0 or later"},
+		"GPL-2.0":          {ID: "GPL-2.0", Name: "GNU General Public License v2.0 only", Deprecated: true},
+		"Zlib":             {ID: "Zlib", Name: "zlib License"},
+	})
+
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"GNU General Public License v2.0 only", "GPL-2.0-only"},
+		{"GNU General Public License, version 2", "GPL-2.0-only"},
+		{"GNU General Public License v2.0 or later", "GPL-2.0-or-later"},
+		{"GNU General Public License version 2 or any later version", "GPL-2.0-or-later"},
+		{"The zlib/libpng License", "Zlib"},
+		{"Apache License, Version 2.0", "Apache-2.0"},
+		{"New BSD License", "BSD-3-Clause"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			got := idx.Match(tc.in)
+			if len(got) == 0 || got[0].ID != tc.want {
+				t.Fatalf("Match(%v) = %v; want %v first", tc.in, got, tc.want)
+			}
+		})
+	}
+
+	// FreeBSD License adds a clause, so it is not an exact match
+	if got := idx.Match("FreeBSD License"); len(got) == 0 || got[0].ID != "BSD-2-Clause" || got[0].Score != internal.LossyNameScore {
+		t.Fatalf("Match(FreeBSD License) = %v; want BSD-2-Clause with score %v", got, internal.LossyNameScore)
+	}
+	if got := idx.Match("Completely Unrelated Words"); len(got) != 0 {
+		t.Fatalf("Match(unrelated) = %v; want none", got)
+	}
+}
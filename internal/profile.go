@@ -0,0 +1,228 @@
+package internal
+
+import "strings"
+
+// How versions that exist both as -only and -or-later (like "GPL-2.0")
+// should be resolved when written without the suffix
+type BareVersion int
+
+const (
+	// Keep bare version as ambiguous short form
+	BareAmbiguous BareVersion = iota
+	// Bare version means -only, trailing "+" means -or-later
+	BareOnly
+	// Both bare version and trailing "+" mean -or-later
+	BareOrLater
+)
+
+// Ecosystem specific rules for resolving tokens to SPDX IDs
+type Profile struct {
+	Name string
+	// Lowercase ecosystem aliases, consulted before global ones
+	Aliases map[string]string
+	// Accept only exact SPDX IDs, without aliases and loose forms
+	Strict bool
+	Bare   BareVersion
+	// Also apply lossy global aliases
+	Lossy bool
+}
+
+var Profiles = map[string]*Profile{
+	"spdx-strict": {
+		Name:   "spdx-strict",
+		Strict: true,
+	},
+	// https://github.com/NixOS/nixpkgs/blob/master/lib/licenses.nix
+	"nixpkgs": {
+		Name: "nixpkgs",
+		Bare: BareOnly,
+		Aliases: map[string]string{
+			"agpl3only":   "AGPL-3.0-only",
+			"agpl3plus":   "AGPL-3.0-or-later",
+			"artistic2":   "Artistic-2.0",
+			"asl11":       "Apache-1.1",
+			"asl20":       "Apache-2.0",
+			"boost":       "BSL-1.0",
+			"bsd0":        "0BSD",
+			"bsd2":        "BSD-2-Clause",
+			"bsd3":        "BSD-3-Clause",
+			"bsdoriginal": "BSD-4-Clause",
+			"bsl11":       "BUSL-1.1",
+			"cc0":         "CC0-1.0",
+			"epl10":       "EPL-1.0",
+			"epl20":       "EPL-2.0",
+			"gpl2":        "GPL-2.0-only",
+			"gpl2only":    "GPL-2.0-only",
+			"gpl2plus":    "GPL-2.0-or-later",
+			"gpl3":        "GPL-3.0-only",
+			"gpl3only":    "GPL-3.0-only",
+			"gpl3plus":    "GPL-3.0-or-later",
+			"lgpl2":       "LGPL-2.0-only",
+			"lgpl2only":   "LGPL-2.0-only",
+			"lgpl2plus":   "LGPL-2.0-or-later",
+			"lgpl21":      "LGPL-2.1-only",
+			"lgpl21only":  "LGPL-2.1-only",
+			"lgpl21plus":  "LGPL-2.1-or-later",
+			"lgpl3":       "LGPL-3.0-only",
+			"lgpl3only":   "LGPL-3.0-only",
+			"lgpl3plus":   "LGPL-3.0-or-later",
+			"mpl11":       "MPL-1.1",
+			"mpl20":       "MPL-2.0",
+			"ncsa":        "NCSA",
+			"ofl":         "OFL-1.1",
+			"psfl":        "PSF-2.0",
+			"sspl":        "SSPL-1.0",
+		},
+	},
+	// https://wiki.gentoo.org/wiki/License_groups
+	"gentoo": {
+		Name: "gentoo",
+		Bare: BareOnly,
+		Aliases: map[string]string{
+			"artistic":   "Artistic-1.0-Perl",
+			"artistic-2": "Artistic-2.0",
+			"boost-1.0":  "BSL-1.0",
+			"bsd":        "BSD-3-Clause",
+			"bsd-2":      "BSD-2-Clause",
+			"bsd-4":      "BSD-4-Clause",
+			"fdl-1.1":    "GFDL-1.1",
+			"fdl-1.2":    "GFDL-1.2",
+			"fdl-1.3":    "GFDL-1.3",
+			"psf-2":      "PSF-2.0",
+			"uoi-ncsa":   "NCSA",
+			"wtfpl-2":    "WTFPL",
+		},
+	},
+	// https://dep-team.pages.debian.net/deps/dep5/#license-short-name
+	"debian": {
+		Name: "debian",
+		Bare: BareOnly,
+		Aliases: map[string]string{
+			"artistic":     "Artistic-1.0",
+			"expat":        "MIT",
+			"gfdl-niv-1.1": "GFDL-1.1-no-invariants",
+			"gfdl-niv-1.2": "GFDL-1.2-no-invariants",
+			"gfdl-niv-1.3": "GFDL-1.3-no-invariants",
+			"psf-2":        "PSF-2.0",
+			"zope":         "ZPL-2.1",
+		},
+	},
+	// Old package.json files often used bare GPL versions as "or later"
+	"npm": {
+		Name: "npm",
+		Bare: BareOrLater,
+		Aliases: map[string]string{
+			"apache2": "Apache-2.0",
+			"gplv2":   "GPL-2.0",
+			"gplv3":   "GPL-3.0",
+			"lgplv3":  "LGPL-3.0",
+			"mpl2":    "MPL-2.0",
+		},
+	},
+	// Trove classifiers like "GNU General Public License v3 (GPLv3)"
+	"pypi": {
+		Name: "pypi",
+		Bare: BareOnly,
+		Aliases: map[string]string{
+			"agplv3":  "AGPL-3.0",
+			"apache2": "Apache-2.0",
+			"gplv2":   "GPL-2.0",
+			"gplv3":   "GPL-3.0",
+			"lgplv2":  "LGPL-2.0",
+			"lgplv3":  "LGPL-3.0",
+			"mpl2":    "MPL-2.0",
+			"psf":     "PSF-2.0",
+		},
+	},
+}
+
+// exactID returns canonical ID for lowercase token only if it is an SPDX ID
+// or deprecated SPDX ID written in any case.
+func (db *DB) exactID(token string) (string, bool) {
+	for _, key := range []string{token, "deprecated_" + token} {
+		if c, ok := db.lookupCanonical(key); ok && strings.ToLower(c) == key {
+			return strings.TrimPrefix(c, "deprecated_"), true
+		}
+	}
+	return "", false
+}
+
+func (db *DB) resolveBare(p *Profile, token string) string {
+	base, plus := strings.CutSuffix(token, "+")
+	if _, ok := db.Files[base+"-only"]; !ok {
+		return token
+	}
+	if _, ok := db.Files[base+"-or-later"]; !ok {
+		return token
+	}
+	switch {
+	case p.Bare == BareAmbiguous:
+		return token
+	case plus || p.Bare == BareOrLater:
+		return base + "-or-later"
+	default:
+		return base + "-only"
+	}
+}
+
+// TokenToCanonicalWith is TokenToCanonical that follows rules of profile p.
+// Nil profile means default rules.
+func (db *DB) TokenToCanonicalWith(token string, p *Profile) string {
+	if p == nil {
+		return db.TokenToCanonical(token)
+	}
+	token = strings.ToLower(token)
+	trimmed, plus := strings.CutSuffix(token, "+")
+	var canon string
+	if alias, ok := p.Aliases[token]; ok {
+		canon = alias
+	} else if alias, ok := p.Aliases[trimmed]; ok && plus {
+		canon = alias + "+"
+	} else if alias, ok := db.lookupLossy(token); ok && p.Lossy && !p.Strict {
+		canon = alias
+	} else if alias, ok := db.lookupLossy(trimmed); ok && plus && p.Lossy && !p.Strict {
+		canon = alias + "+"
+	} else if !p.Strict {
+		canon = db.TokenToCanonical(token)
+	} else if id, ok := db.exactID(token); ok {
+		canon = id
+	} else if id, ok := db.exactID(trimmed); ok && plus {
+		canon = id + "+"
+	} else {
+		canon = token
+	}
+	return db.resolveBare(p, canon)
+}
+
+// TokensToCanonicalWith is TokensToCanonical that follows rules of profile p.
+// Nil profile means default rules.
+func (db *DB) TokensToCanonicalWith(tokens []string, p *Profile) []string {
+	if p == nil {
+		return db.TokensToCanonical(tokens)
+	}
+	canon := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if token == "" || token == " " {
+			continue
+		}
+		token := strings.ToLower(token)
+		_, aliased := p.Aliases[token]
+		depr, ok := db.Deprecated[token]
+		if ok && !aliased && !p.Strict {
+			canon = append(canon, db.TokensToCanonicalWith(depr, p)...)
+			continue
+		}
+		canon = append(canon, db.TokenToCanonicalWith(token, p))
+	}
+	return canon
+}
+
+// TokeniseWith is Tokenise that follows rules of profile p.
+// Nil profile means default rules.
+func (db *DB) TokeniseWith(text string, p *Profile) []string {
+	tokens := strings.Fields(text)
+	if p == nil || !p.Strict {
+		tokens = db.mergePhrases(tokens, p != nil && p.Lossy)
+	}
+	return db.TokensToCanonicalWith(tokens, p)
+}
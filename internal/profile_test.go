@@ -0,0 +1,47 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+func Test_ProfileAliases(t *testing.T) {
+	db := internal.Default()
+	for name, profile := range internal.Profiles {
+		for alias, id := range profile.Aliases {
+			_, isFile := db.Files[id]
+			_, isGlob := db.Globs[id]
+			if !isFile && !isGlob {
+				t.Errorf("profile %v: alias %v points to unknown ID %v", name, alias, id)
+			}
+		}
+	}
+}
+
+func Test_TokenToCanonicalWith(t *testing.T) {
+	tests := []struct {
+		profile string
+		in      string
+		want    string
+	}{
+		{"spdx-strict", "gpl-3.0-or-later", "GPL-3.0-or-later"},
+		{"spdx-strict", "gpl3+", "gpl3+"},
+		{"spdx-strict", "mit+", "MIT+"},
+		{"gentoo", "gpl-3", "GPL-3.0-only"},
+		{"gentoo", "gpl-3+", "GPL-3.0-or-later"},
+		{"npm", "agpl-3.0", "AGPL-3.0-or-later"},
+		{"nixpkgs", "lgpl21Plus", "LGPL-2.1-or-later"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.profile+" "+tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			got := internal.Default().TokenToCanonicalWith(tc.in, internal.Profiles[tc.profile])
+			if got != tc.want {
+				t.Fatalf("TokenToCanonicalWith(%v, %v) = %v; want %v", tc.in, tc.profile, got, tc.want)
+			}
+		})
+	}
+}
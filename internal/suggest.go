@@ -0,0 +1,196 @@
+package internal
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Candidates with lower similarity scores are not suggested
+const MinSuggestionScore = 0.6
+
+// Suggested ID (or ambiguous short form) with similarity score in (0, 1]
+type Candidate struct {
+	ID    string
+	Score float64
+}
+
+// sortCandidates orders candidates by descending score, then by popularity,
+// then shorter and alphabetically first.
+func sortCandidates(candidates []Candidate) {
+	slices.SortFunc(candidates, func(a, b Candidate) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			cmp.Compare(popularity(a.ID), popularity(b.ID)),
+			cmp.Compare(len(a.ID), len(b.ID)),
+			strings.Compare(a.ID, b.ID),
+		)
+	})
+}
+
+type suggestKey struct {
+	key    string
+	tokens []string
+	target string
+}
+
+// SplitWords splits s into lowercase runs of letters and runs of digits.
+// Example: "BSD3-Clause" -> ["bsd", "3", "clause"]
+func SplitWords(s string) []string {
+	words := make([]string, 0)
+	start := -1
+	var prevDigit bool
+	for i, r := range s {
+		isLetter, isDigit := unicode.IsLetter(r), unicode.IsDigit(r)
+		if start >= 0 && (!(isLetter || isDigit) || isDigit != prevDigit) {
+			words = append(words, strings.ToLower(s[start:i]))
+			start = -1
+		}
+		if start < 0 && (isLetter || isDigit) {
+			start = i
+		}
+		prevDigit = isDigit
+	}
+	if start >= 0 {
+		words = append(words, strings.ToLower(s[start:]))
+	}
+	return words
+}
+
+// EditDistance returns optimal string alignment distance between a and b,
+// that is Levenshtein distance that also counts adjacent transpositions.
+func EditDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+	return prev[len(rb)]
+}
+
+// Jaccard index of two word lists treated as sets
+func wordsSimilarity(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	common := 0
+	for _, w := range DedupInPlace(slices.Clone(a)) {
+		if slices.Contains(b, w) {
+			common++
+		}
+	}
+	union := len(DedupInPlace(slices.Concat(a, b)))
+	return float64(common) / float64(union)
+}
+
+// Similarity scores how close token is to key, both in lower case.
+// Normalised edit distance is boosted by similarity of word sets, so
+// "bsd3-clause" is closer to "bsd-3-clause" than to "bsd-2-clause".
+func Similarity(token, key string) float64 {
+	return similarity(token, key, SplitWords(token), SplitWords(key))
+}
+
+func similarity(token, key string, tokenWords, keyWords []string) float64 {
+	longest := max(len(token), len(key))
+	if longest == 0 {
+		return 0
+	}
+	edit := 1 - float64(EditDistance(token, key))/float64(longest)
+	return max(edit, 0.6*edit+0.4*wordsSimilarity(tokenWords, keyWords))
+}
+
+func (db *DB) buildSuggestKeys() []suggestKey {
+	targets := make(map[string]string)
+	for _, file := range db.Filenames {
+		targets[strings.ToLower(file)] = strings.TrimPrefix(file, "deprecated_")
+	}
+	for glob, files := range db.Globs {
+		if strings.HasSuffix(glob, "-") || strings.HasSuffix(glob, ".") {
+			continue
+		}
+		if strings.HasSuffix(glob, ".0.0") {
+			continue
+		}
+		if len(files) == 1 {
+			// Short form of exactly one ID
+			targets[strings.ToLower(glob)] = strings.TrimPrefix(files[0], "deprecated_")
+			continue
+		}
+		targets[strings.ToLower(glob)] = glob
+	}
+	db.aliasesMu.RLock()
+	for form, target := range db.Canonical {
+		if slices.Contains(Keywords, target) {
+			continue
+		}
+		targets[form] = strings.TrimPrefix(target, "deprecated_")
+	}
+	db.aliasesMu.RUnlock()
+	keys := make([]suggestKey, 0, len(targets))
+	for key, target := range targets {
+		if len(key) < 2 {
+			continue
+		}
+		keys = append(keys, suggestKey{key, SplitWords(key), target})
+	}
+	return keys
+}
+
+func (db *DB) getSuggestKeys() []suggestKey {
+	db.suggestMu.Lock()
+	defer db.suggestMu.Unlock()
+	if db.suggestKeys == nil {
+		db.suggestKeys = db.buildSuggestKeys()
+	}
+	return db.suggestKeys
+}
+
+func (db *DB) resetSuggestions() {
+	db.suggestMu.Lock()
+	db.suggestKeys = nil
+	db.suggestMu.Unlock()
+}
+
+// Suggest returns up to limit known IDs, aliases and short forms similar to
+// token, best first. Non-positive limit means no limit.
+func (db *DB) Suggest(token string, limit int) []Candidate {
+	token = strings.ToLower(strings.TrimSpace(token))
+	if token == "" {
+		return []Candidate{}
+	}
+	words := SplitWords(token)
+	best := make(map[string]float64)
+	for _, key := range db.getSuggestKeys() {
+		score := similarity(token, key.key, words, key.tokens)
+		if score < MinSuggestionScore || score <= best[key.target] {
+			continue
+		}
+		best[key.target] = score
+	}
+	candidates := make([]Candidate, 0, len(best))
+	for id, score := range best {
+		candidates = append(candidates, Candidate{id, score})
+	}
+	sortCandidates(candidates)
+	if limit > 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates
+}
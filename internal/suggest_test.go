@@ -0,0 +1,57 @@
+package internal_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+func Test_SplitWords(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"", []string{}},
+		{"BSD3-Clause", []string{"bsd", "3", "clause"}},
+		{"LGPL-2.1+", []string{"lgpl", "2", "1"}},
+		{"--a--", []string{"a"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			got := internal.SplitWords(tc.in)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("SplitWords(%v) = %v; want %v", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func Test_EditDistance(t *testing.T) {
+	tests := []struct {
+		a    string
+		b    string
+		want int
+	}{
+		{"", "", 0},
+		{"", "mit", 3},
+		{"mit", "mit", 0},
+		{"glp", "gpl", 1},
+		{"apache", "apachee", 1},
+		{"kitten", "sitting", 3},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.a+" "+tc.b, func(t *testing.T) {
+			t.Parallel()
+
+			got := internal.EditDistance(tc.a, tc.b)
+			if got != tc.want {
+				t.Fatalf("EditDistance(%v, %v) = %v; want %v", tc.a, tc.b, got, tc.want)
+			}
+		})
+	}
+}
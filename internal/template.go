@@ -0,0 +1,281 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// File mapping names to templates in blobs/ in archives produced by
+// genembed.go
+const TemplatesFile = "templates.json"
+
+var ErrInvalidTemplate = errors.New("invalid license template")
+
+// Kind of TemplateNode
+type NodeKind int
+
+const (
+	TextNode NodeKind = iota
+	VarNode
+	OptionalNode
+)
+
+// TemplateNode is a part of SPDX license template
+type TemplateNode struct {
+	Kind NodeKind
+	// Literal text of TextNode
+	Text string
+	// Attributes of VarNode: name, original text and regular expression
+	// matching allowed replacements
+	Name     string
+	Original string
+	Match    string
+	// Children of OptionalNode
+	Children []TemplateNode
+}
+
+// Starts of var attributes: `name="`, `;original="`
+var templateAttrRe = regexp.MustCompile(`(?:^|";)\s*(\w+)="`)
+
+// parseTemplateAttrs parses `name="a";original="b "quoted"";match="c"`.
+// Values may contain unescaped quotes, so only `";` followed by
+// attribute name ends value.
+func parseTemplateAttrs(s string) map[string]string {
+	attrs := make(map[string]string)
+	locs := templateAttrRe.FindAllStringSubmatchIndex(s, -1)
+	for i, loc := range locs {
+		end := len(s)
+		if i+1 < len(locs) {
+			end = locs[i+1][0]
+		}
+		value := s[loc[1]:end]
+		if i+1 == len(locs) {
+			value = strings.TrimSuffix(value, `"`)
+		}
+		attrs[s[loc[2]:loc[3]]] = value
+	}
+	return attrs
+}
+
+// ParseTemplate parses SPDX license template with
+// <<var;name="...";original="...";match="...">> and
+// <<beginOptional>>...<<endOptional>> markup.
+func ParseTemplate(s string) ([]TemplateNode, error) {
+	// Stack of open optional sections, root first
+	stack := [][]TemplateNode{nil}
+	addText := func(text string) {
+		if text == "" {
+			return
+		}
+		top := &stack[len(stack)-1]
+		if n := len(*top); n > 0 && (*top)[n-1].Kind == TextNode {
+			(*top)[n-1].Text += text
+			return
+		}
+		*top = append(*top, TemplateNode{Kind: TextNode, Text: text})
+	}
+	for s != "" {
+		i := strings.Index(s, "<<")
+		if i < 0 {
+			addText(s)
+			break
+		}
+		addText(s[:i])
+		s = s[i:]
+		switch {
+		case strings.HasPrefix(s, "<<beginOptional"):
+			end := strings.Index(s, ">>")
+			if end < 0 {
+				return nil, fmt.Errorf("%w: unterminated beginOptional", ErrInvalidTemplate)
+			}
+			stack = append(stack, nil)
+			s = s[end+2:]
+		case strings.HasPrefix(s, "<<endOptional>>"):
+			if len(stack) == 1 {
+				return nil, fmt.Errorf("%w: endOptional without beginOptional", ErrInvalidTemplate)
+			}
+			children := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			top := &stack[len(stack)-1]
+			*top = append(*top, TemplateNode{Kind: OptionalNode, Children: children})
+			s = s[len("<<endOptional>>"):]
+		case strings.HasPrefix(s, "<<var;"):
+			end := strings.Index(s, `">>`)
+			if end < 0 {
+				return nil, fmt.Errorf("%w: unterminated var", ErrInvalidTemplate)
+			}
+			attrs := parseTemplateAttrs(s[len("<<var;") : end+1])
+			top := &stack[len(stack)-1]
+			*top = append(*top, TemplateNode{
+				Kind:     VarNode,
+				Name:     attrs["name"],
+				Original: attrs["original"],
+				Match:    attrs["match"],
+			})
+			s = s[end+3:]
+		default:
+			// Literal "<<"
+			addText("<<")
+			s = s[2:]
+		}
+	}
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("%w: beginOptional without endOptional", ErrInvalidTemplate)
+	}
+	return stack[0], nil
+}
+
+// FillTemplate returns text of template with vars substituted by name.
+// Vars missing from vars keep their original text. Optional parts are
+// kept.
+func FillTemplate(nodes []TemplateNode, vars map[string]string) string {
+	var b strings.Builder
+	var fill func(nodes []TemplateNode)
+	fill = func(nodes []TemplateNode) {
+		for _, n := range nodes {
+			switch n.Kind {
+			case TextNode:
+				b.WriteString(n.Text)
+			case VarNode:
+				if v, ok := vars[n.Name]; ok {
+					b.WriteString(v)
+				} else {
+					b.WriteString(n.Original)
+				}
+			case OptionalNode:
+				fill(n.Children)
+			}
+		}
+	}
+	fill(nodes)
+	return b.String()
+}
+
+// Bounded repeats like {0,5000}
+var repeatRe = regexp.MustCompile(`\{(\d+),(\d+)\}`)
+
+// varPattern converts match attribute of var, written for Java regular
+// expressions, to RE2 syntax. Repeats over RE2 limit become unbounded.
+// Unsupported patterns match anything.
+func varPattern(match string) string {
+	if match == "" {
+		return ".*?"
+	}
+	match = repeatRe.ReplaceAllStringFunc(match, func(r string) string {
+		m := repeatRe.FindStringSubmatch(r)
+		if hi, _ := strconv.Atoi(m[2]); hi > 1000 {
+			return "{" + m[1] + ",}"
+		}
+		return r
+	})
+	if _, err := syntax.Parse(match, syntax.Perl); err != nil {
+		return ".*?"
+	}
+	return match
+}
+
+// TemplatePattern returns regular expression matching texts of template
+// nodes. Matching is case-insensitive and treats runs of whitespace as
+// equal; whitespace around vars and optional parts may be missing.
+func TemplatePattern(nodes []TemplateNode) string {
+	var b strings.Builder
+	var build func(nodes []TemplateNode)
+	build = func(nodes []TemplateNode) {
+		for _, n := range nodes {
+			switch n.Kind {
+			case TextNode:
+				if strings.TrimLeftFunc(n.Text, unicode.IsSpace) != n.Text {
+					b.WriteString(`\s*`)
+				}
+				for i, word := range strings.Fields(n.Text) {
+					if i > 0 {
+						b.WriteString(`\s+`)
+					}
+					b.WriteString(regexp.QuoteMeta(word))
+				}
+				if strings.TrimRightFunc(n.Text, unicode.IsSpace) != n.Text {
+					b.WriteString(`\s*`)
+				}
+			case VarNode:
+				b.WriteString("(?:" + varPattern(n.Match) + ")")
+			case OptionalNode:
+				b.WriteString("(?:")
+				build(n.Children)
+				b.WriteString(")?")
+			}
+		}
+	}
+	b.WriteString(`(?is)^\s*`)
+	build(nodes)
+	b.WriteString(`\s*$`)
+	return b.String()
+}
+
+// loadTemplates finds templates: TemplatesFile for indexed layout,
+// "template/<ID>.template.txt" for license-list-data repository.
+func (db *DB) loadTemplates(l layout) error {
+	if l.index != "" {
+		data, err := fs.ReadFile(db.fsys, TemplatesFile)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var index map[string]string
+		if err := json.Unmarshal(data, &index); err != nil {
+			return fmt.Errorf("%s: %w", TemplatesFile, err)
+		}
+		db.Templates = make(map[string]string, len(index))
+		for name, hash := range index {
+			db.Templates[name] = path.Join(l.dir, hash)
+		}
+		return nil
+	}
+	if l != releaseLayout {
+		return nil
+	}
+	entries, err := fs.ReadDir(db.fsys, "template")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	db.Templates = make(map[string]string, len(entries))
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), ".template.txt")
+		if e.IsDir() || !ok || name == "" {
+			continue
+		}
+		db.Templates[name] = path.Join("template", e.Name())
+	}
+	return nil
+}
+
+// ReadTemplate returns template of license file name.
+func (db *DB) ReadTemplate(name string) (string, error) {
+	p, ok := db.Templates[name]
+	if !ok {
+		p, ok = db.Templates[strings.TrimPrefix(name, "deprecated_")]
+	}
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrNoTemplate, name)
+	}
+	if db.noText {
+		return "", ErrNoText
+	}
+	data, err := fs.ReadFile(db.fsys, p)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,113 @@
+package internal_test
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+func Test_ParseTemplate(t *testing.T) {
+	t.Parallel()
+	text := func(s string) internal.TemplateNode {
+		return internal.TemplateNode{Kind: internal.TextNode, Text: s}
+	}
+	cases := []struct {
+		name string
+		in   string
+		want []internal.TemplateNode
+	}{
+		{"plain", "MIT License", []internal.TemplateNode{text("MIT License")}},
+		{"literal brackets", "a << b", []internal.TemplateNode{text("a << b")}},
+		{
+			"var",
+			`Copyright <<var;name="copyright";original="Copyright (c) <year>";match=".{0,5000}">> now`,
+			[]internal.TemplateNode{
+				text("Copyright "),
+				{Kind: internal.VarNode, Name: "copyright", Original: "Copyright (c) <year>", Match: ".{0,5000}"},
+				text(" now"),
+			},
+		},
+		{
+			"quotes in original",
+			`<<var;name="software";original="files (the "Software")";match="files \(the "?Software"?\)">>`,
+			[]internal.TemplateNode{
+				{Kind: internal.VarNode, Name: "software", Original: `files (the "Software")`, Match: `files \(the "?Software"?\)`},
+			},
+		},
+		{
+			"nested optional",
+			"<<beginOptional>>A<<beginOptional>>B<<endOptional>><<endOptional>>C",
+			[]internal.TemplateNode{
+				{Kind: internal.OptionalNode, Children: []internal.TemplateNode{
+					text("A"),
+					{Kind: internal.OptionalNode, Children: []internal.TemplateNode{text("B")}},
+				}},
+				text("C"),
+			},
+		},
+		{
+			"named optional",
+			`<<beginOptional;name="title">>Title<<endOptional>>`,
+			[]internal.TemplateNode{
+				{Kind: internal.OptionalNode, Children: []internal.TemplateNode{text("Title")}},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := internal.ParseTemplate(c.in)
+			if err != nil {
+				t.Fatalf("ParseTemplate(%q) error: %v", c.in, err)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("ParseTemplate(%q) = %#v; want %#v", c.in, got, c.want)
+			}
+		})
+	}
+}
+
+func Test_ParseTemplateError(t *testing.T) {
+	t.Parallel()
+	for _, in := range []string{
+		"<<beginOptional>>a",
+		"a<<endOptional>>",
+		`<<var;name="a"`,
+		"<<beginOptional",
+	} {
+		if _, err := internal.ParseTemplate(in); !errors.Is(err, internal.ErrInvalidTemplate) {
+			t.Fatalf("ParseTemplate(%q) error = %v; want %v", in, err, internal.ErrInvalidTemplate)
+		}
+	}
+}
+
+func Test_TemplatePattern(t *testing.T) {
+	t.Parallel()
+	nodes, err := internal.ParseTemplate(
+		`<<beginOptional>>Foo License<<endOptional>>
+
+<<var;name="copyright";original="Copyright (c) <year>";match=".{0,5000}">>
+
+Permission is granted to <<var;name="who";original="anyone";match="anyone|everyone">>.`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		in   string
+		want bool
+	}{
+		{"Foo License\n\nCopyright (c) 2024 Bob\n\nPermission is granted to anyone.", true},
+		{"Copyright 2024 Bob\nPermission   is\ngranted to EVERYONE.", true},
+		{"Copyright 2024 Bob\nPermission is granted to nobody.", false},
+		{"Permission is granted to anyone. And more", false},
+	}
+	re := regexp.MustCompile(internal.TemplatePattern(nodes))
+	for _, c := range cases {
+		if got := re.MatchString(c.in); got != c.want {
+			t.Fatalf("TemplatePattern() matches %q = %v; want %v", c.in, got, c.want)
+		}
+	}
+}
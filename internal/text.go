@@ -0,0 +1,135 @@
+package internal
+
+import (
+	"container/list"
+	"fmt"
+	"io"
+	"io/fs"
+	"sync"
+)
+
+// OpenText opens text of license file name for streaming.
+func (db *DB) OpenText(name string) (io.ReadCloser, error) {
+	path, ok := db.Files[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownID, name)
+	}
+	if db.noText {
+		return nil, ErrNoText
+	}
+	return db.fsys.Open(path)
+}
+
+// ReadText returns text of license file name. Texts are kept in cache
+// if it is enabled with SetTextCacheSize.
+func (db *DB) ReadText(name string) (string, error) {
+	if text, ok := db.texts.get(name); ok {
+		return text, nil
+	}
+	path, ok := db.Files[name]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownID, name)
+	}
+	if db.noText {
+		return "", ErrNoText
+	}
+	data, err := fs.ReadFile(db.fsys, path)
+	if err != nil {
+		return "", err
+	}
+	text := string(data)
+	db.texts.put(name, text)
+	return text, nil
+}
+
+// HasTexts reports whether license texts are available. They are not in
+// embedded databases built with licensedb_notext build tag.
+func (db *DB) HasTexts() bool {
+	return !db.noText
+}
+
+// SetTextCacheSize limits total size in bytes of texts cached by
+// ReadText. Zero disables cache and drops cached texts.
+func (db *DB) SetTextCacheSize(size int) {
+	db.texts.resize(size)
+}
+
+// LRU cache of decompressed texts bounded by their total size
+type textCache struct {
+	mu    sync.Mutex
+	limit int
+	size  int
+	// Least recently used at the back
+	order   list.List
+	entries map[string]*list.Element
+}
+
+type textEntry struct {
+	name, text string
+}
+
+func (c *textCache) get(name string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[name]
+	if !ok {
+		return "", false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*textEntry).text, true
+}
+
+func (c *textCache) put(name, text string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(text) > c.limit {
+		return
+	}
+	if _, ok := c.entries[name]; ok {
+		return
+	}
+	if c.entries == nil {
+		c.entries = make(map[string]*list.Element)
+	}
+	c.entries[name] = c.order.PushFront(&textEntry{name, text})
+	c.size += len(text)
+	c.evict()
+}
+
+func (c *textCache) resize(limit int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.limit = max(limit, 0)
+	c.evict()
+}
+
+// evict drops least recently used texts until cache fits into limit
+func (c *textCache) evict() {
+	for c.size > c.limit {
+		e := c.order.Back()
+		entry := c.order.Remove(e).(*textEntry)
+		delete(c.entries, entry.name)
+		c.size -= len(entry.text)
+	}
+}
+
+// SameText reports whether license files a and b have identical texts.
+// It is false if any of them is unknown or can't be read.
+func (db *DB) SameText(a, b string) bool {
+	pa, okA := db.Files[a]
+	pb, okB := db.Files[b]
+	switch {
+	case !okA || !okB:
+		return false
+	case pa == pb:
+		return true
+	case db.contentAddressed:
+		return false
+	}
+	ta, err := db.ReadText(a)
+	if err != nil {
+		return false
+	}
+	tb, err := db.ReadText(b)
+	return err == nil && ta == tb
+}
@@ -0,0 +1,121 @@
+package internal_test
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+// FS whose files can be listed but not read
+type brokenFS struct {
+	files fstest.MapFS
+}
+
+func (f brokenFS) Open(name string) (fs.File, error) {
+	if _, ok := f.files[name]; ok {
+		return nil, fs.ErrPermission
+	}
+	return f.files.Open(name)
+}
+
+func Test_ReadText(t *testing.T) {
+	t.Parallel()
+	fsys := fstest.MapFS{
+		"MIT":        {Data: []byte("MIT License")},
+		"Apache-2.0": {Data: []byte("Apache License")},
+	}
+	db, err := internal.NewDB(fsys)
+	if err != nil {
+		t.Fatalf("NewDB() error: %v", err)
+	}
+
+	r, err := db.OpenText("MIT")
+	if err != nil {
+		t.Fatalf("OpenText(MIT) error: %v", err)
+	}
+	data, _ := io.ReadAll(r)
+	r.Close()
+	if string(data) != "MIT License" {
+		t.Fatalf("OpenText(MIT) = %q; want MIT License", data)
+	}
+	if _, err := db.OpenText("GPL-3.0"); !errors.Is(err, internal.ErrUnknownID) {
+		t.Fatalf("OpenText(GPL-3.0) error = %v; want %v", err, internal.ErrUnknownID)
+	}
+	if _, err := db.ReadText("GPL-3.0"); !errors.Is(err, internal.ErrUnknownID) {
+		t.Fatalf("ReadText(GPL-3.0) error = %v; want %v", err, internal.ErrUnknownID)
+	}
+
+	// Only MIT fits into cache
+	db.SetTextCacheSize(len("MIT License"))
+	db.ReadText("MIT")
+	db.ReadText("Apache-2.0")
+	fsys["MIT"].Data = []byte("changed")
+	fsys["Apache-2.0"].Data = []byte("changed")
+	if got, _ := db.ReadText("MIT"); got != "MIT License" {
+		t.Fatalf("ReadText(MIT) = %q; want cached MIT License", got)
+	}
+	if got, _ := db.ReadText("Apache-2.0"); got != "changed" {
+		t.Fatalf("ReadText(Apache-2.0) = %q; want changed", got)
+	}
+	db.SetTextCacheSize(0)
+	if got, _ := db.ReadText("MIT"); got != "changed" {
+		t.Fatalf("ReadText(MIT) with disabled cache = %q; want changed", got)
+	}
+}
+
+func Test_ReadTextError(t *testing.T) {
+	t.Parallel()
+	db, err := internal.NewDB(brokenFS{fstest.MapFS{"MIT": {Data: []byte("MIT License")}}})
+	if err != nil {
+		t.Fatalf("NewDB() error: %v", err)
+	}
+	if _, err := db.ReadText("MIT"); !errors.Is(err, fs.ErrPermission) {
+		t.Fatalf("ReadText(MIT) error = %v; want %v", err, fs.ErrPermission)
+	}
+	if got := db.GetText("MIT"); got != nil {
+		t.Fatalf("GetText(MIT) = %v; want nil", *got)
+	}
+}
+
+func Test_SameText(t *testing.T) {
+	t.Parallel()
+	indexed, err := internal.NewDB(fstest.MapFS{
+		"index.json": {Data: []byte(`{"GPL-3.0-only": "a", "GPL-3.0-or-later": "a", "MIT": "b"}`)},
+		"blobs/a":    {Data: []byte("GPL 3")},
+		"blobs/b":    {Data: []byte("MIT License")},
+	})
+	if err != nil {
+		t.Fatalf("NewDB() error: %v", err)
+	}
+	plain, err := internal.NewDB(fstest.MapFS{
+		"GPL-3.0-only":     {Data: []byte("GPL 3")},
+		"GPL-3.0-or-later": {Data: []byte("GPL 3")},
+		"MIT":              {Data: []byte("MIT License")},
+	})
+	if err != nil {
+		t.Fatalf("NewDB() error: %v", err)
+	}
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"GPL-3.0-only", "GPL-3.0-or-later", true},
+		{"GPL-3.0-only", "GPL-3.0-only", true},
+		{"GPL-3.0-only", "MIT", false},
+		{"GPL-3.0-only", "Apache-2.0", false},
+	}
+	for _, c := range cases {
+		t.Run(c.a+"_"+c.b, func(t *testing.T) {
+			t.Parallel()
+			for name, db := range map[string]*internal.DB{"indexed": indexed, "plain": plain} {
+				if got := db.SameText(c.a, c.b); got != c.want {
+					t.Fatalf("%s: SameText(%v, %v) = %v; want %v", name, c.a, c.b, got, c.want)
+				}
+			}
+		})
+	}
+}
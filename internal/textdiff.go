@@ -0,0 +1,268 @@
+package internal
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Kind of Edit
+type EditOp int
+
+const (
+	OpEqual EditOp = iota
+	OpDelete
+	OpInsert
+)
+
+// Edit is a token kept, deleted from old sequence or inserted into new one
+type Edit struct {
+	Op   EditOp
+	Text string
+}
+
+// Diff returns shortest edit script turning a into b.
+func Diff(a, b []string) []Edit {
+	// Stripping common prefix and suffix keeps search space small for
+	// typical texts with few corrections
+	pre := 0
+	for pre < len(a) && pre < len(b) && a[pre] == b[pre] {
+		pre++
+	}
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && a[len(a)-1-suf] == b[len(b)-1-suf] {
+		suf++
+	}
+	edits := make([]Edit, 0, len(a)+len(b))
+	for _, t := range a[:pre] {
+		edits = append(edits, Edit{OpEqual, t})
+	}
+	edits = append(edits, myers(a[pre:len(a)-suf], b[pre:len(b)-suf])...)
+	for _, t := range a[len(a)-suf:] {
+		edits = append(edits, Edit{OpEqual, t})
+	}
+	return edits
+}
+
+// myers implements Myers' O(ND) diff algorithm
+func myers(a, b []string) []Edit {
+	n, m := len(a), len(b)
+	offset := n + m
+	v := make([]int, 2*offset+2)
+	// trace[d] holds furthest reaching x for diagonals -d..d before step d
+	var trace [][]int
+search:
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, slices.Clone(v[offset-d:offset+d+1]))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	edits := make([]Edit, 0, n+m)
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		prev := func(k int) int { return trace[d][k+d] }
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && prev(k-1) < prev(k+1)) {
+			prevK = k + 1
+		}
+		prevX := prev(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			edits = append(edits, Edit{OpEqual, a[x-1]})
+			x--
+			y--
+		}
+		if x == prevX {
+			edits = append(edits, Edit{OpInsert, b[y-1]})
+			y--
+		} else {
+			edits = append(edits, Edit{OpDelete, a[x-1]})
+			x--
+		}
+	}
+	for x > 0 && y > 0 {
+		edits = append(edits, Edit{OpEqual, a[x-1]})
+		x--
+		y--
+	}
+	slices.Reverse(edits)
+	return edits
+}
+
+// Group of line edits with surrounding context
+type hunk struct {
+	// Zero-based first line and number of lines in old and new text
+	aStart, aLen int
+	bStart, bLen int
+	edits        []Edit
+}
+
+func (h hunk) header() string {
+	pos := func(start, n int) int {
+		if n == 0 {
+			return start
+		}
+		return start + 1
+	}
+	return fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", pos(h.aStart, h.aLen), h.aLen, pos(h.bStart, h.bLen), h.bLen)
+}
+
+// hunks groups changes that are closer than 2*context lines to each other
+func hunks(edits []Edit, context int) []hunk {
+	// Positions in old and new text before each edit
+	apos := make([]int, len(edits)+1)
+	bpos := make([]int, len(edits)+1)
+	for i, e := range edits {
+		apos[i+1], bpos[i+1] = apos[i], bpos[i]
+		if e.Op != OpInsert {
+			apos[i+1]++
+		}
+		if e.Op != OpDelete {
+			bpos[i+1]++
+		}
+	}
+	var result []hunk
+	for i := 0; i < len(edits); {
+		if edits[i].Op == OpEqual {
+			i++
+			continue
+		}
+		start := max(i-context, 0)
+		end := i + 1
+		for j := end; j < len(edits) && j < end+2*context; j++ {
+			if edits[j].Op != OpEqual {
+				end = j + 1
+			}
+		}
+		stop := min(end+context, len(edits))
+		result = append(result, hunk{
+			aStart: apos[start], aLen: apos[stop] - apos[start],
+			bStart: bpos[start], bLen: bpos[stop] - bpos[start],
+			edits: edits[start:stop],
+		})
+		i = end
+	}
+	return result
+}
+
+// Marker of last line without line break, like in diff and git diff
+const noNewline = "\\ No newline at end of file"
+
+// splitLines splits text into lines. Last line without line break gets
+// noNewline on a line of its own, so it differs from the same line with
+// line break and is shown with the marker by UnifiedDiff.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	trimmed, ok := strings.CutSuffix(text, "\n")
+	lines := strings.Split(trimmed, "\n")
+	if !ok {
+		lines[len(lines)-1] += "\n" + noNewline
+	}
+	return lines
+}
+
+// UnifiedDiff returns unified diff of old and new texts with context
+// lines around changes. It is empty if texts are equal.
+func UnifiedDiff(oldName, newName, old, new string, context int) string {
+	hs := hunks(Diff(splitLines(old), splitLines(new)), context)
+	if len(hs) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldName, newName)
+	for _, h := range hs {
+		b.WriteString(h.header())
+		for _, e := range h.edits {
+			b.WriteString([]string{" ", "-", "+"}[e.Op])
+			b.WriteString(e.Text)
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// WordDiff returns diff of old and new texts like UnifiedDiff, but
+// changed lines are shown once with removed words as [-words-] and
+// added ones as {+words+}. Line breaks inside changed lines are not kept.
+func WordDiff(oldName, newName, old, new string, context int) string {
+	hs := hunks(Diff(splitLines(old), splitLines(new)), context)
+	if len(hs) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldName, newName)
+	for _, h := range hs {
+		b.WriteString(h.header())
+		for i := 0; i < len(h.edits); {
+			if h.edits[i].Op == OpEqual {
+				b.WriteString(h.edits[i].Text)
+				b.WriteByte('\n')
+				i++
+				continue
+			}
+			var oldWords, newWords []string
+			var oldMarker, newMarker bool
+			for ; i < len(h.edits) && h.edits[i].Op != OpEqual; i++ {
+				text, marker := strings.CutSuffix(h.edits[i].Text, "\n"+noNewline)
+				words := strings.Fields(text)
+				if h.edits[i].Op == OpDelete {
+					oldWords = append(oldWords, words...)
+					oldMarker = oldMarker || marker
+				} else {
+					newWords = append(newWords, words...)
+					newMarker = newMarker || marker
+				}
+			}
+			b.WriteString(renderWords(Diff(oldWords, newWords)))
+			b.WriteByte('\n')
+			switch {
+			case oldMarker && newMarker:
+				b.WriteString(noNewline + "\n")
+			case oldMarker:
+				b.WriteString("[-" + noNewline + "-]\n")
+			case newMarker:
+				b.WriteString("{+" + noNewline + "+}\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+func renderWords(edits []Edit) string {
+	parts := []string{}
+	for i := 0; i < len(edits); {
+		op := edits[i].Op
+		words := []string{}
+		for ; i < len(edits) && edits[i].Op == op; i++ {
+			words = append(words, edits[i].Text)
+		}
+		text := strings.Join(words, " ")
+		switch op {
+		case OpDelete:
+			text = "[-" + text + "-]"
+		case OpInsert:
+			text = "{+" + text + "+}"
+		}
+		parts = append(parts, text)
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,59 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+func Test_UnifiedDiff(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		old, new string
+		want     string
+	}{
+		{"equal", "a\nb\n", "a\nb\n", ""},
+		{"changed", "a\nb\nc\n", "a\nB\nc\n", "--- old\n+++ new\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n"},
+		{"inserted", "a\n", "a\nb\n", "--- old\n+++ new\n@@ -1,1 +1,2 @@\n a\n+b\n"},
+		{"from empty", "", "a\n", "--- old\n+++ new\n@@ -0,0 +1,1 @@\n+a\n"},
+		{"newline removed", "x\n", "x", "--- old\n+++ new\n@@ -1,1 +1,1 @@\n-x\n+x\n\\ No newline at end of file\n"},
+		{"both without newline", "a\nb", "a\nc", "--- old\n+++ new\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+c\n\\ No newline at end of file\n"},
+		{
+			"two hunks",
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			"0\n2\n3\n4\n5\n6\n7\n8\n0\n",
+			"--- old\n+++ new\n@@ -1,2 +1,2 @@\n-1\n+0\n 2\n@@ -8,2 +8,2 @@\n 8\n-9\n+0\n",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			if got := internal.UnifiedDiff("old", "new", c.old, c.new, 1); got != c.want {
+				t.Fatalf("UnifiedDiff(%q, %q) = %q; want %q", c.old, c.new, got, c.want)
+			}
+		})
+	}
+}
+
+func Test_WordDiff(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		old, new string
+		want     string
+	}{
+		{"equal", "a b\n", "a b\n", ""},
+		{"newline added", "a b", "a b\n", "--- old\n+++ new\n@@ -1,1 +1,1 @@\na b\n[-\\ No newline at end of file-]\n"},
+		{"word", "x\nthe quick fox\n", "x\nthe slow fox\n", "--- old\n+++ new\n@@ -1,2 +1,2 @@\nx\nthe [-quick-] {+slow+} fox\n"},
+		{"reflow", "one two\nthree\n", "one two three four\n", "--- old\n+++ new\n@@ -1,2 +1,1 @@\none two three {+four+}\n"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			if got := internal.WordDiff("old", "new", c.old, c.new, 1); got != c.want {
+				t.Fatalf("WordDiff(%q, %q) = %q; want %q", c.old, c.new, got, c.want)
+			}
+		})
+	}
+}
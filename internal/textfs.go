@@ -0,0 +1,179 @@
+package internal
+
+import (
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Directories of TextFS
+const (
+	LicensesDir   = "licenses"
+	ExceptionsDir = "exceptions"
+)
+
+// TextFS is read-only fs.FS of license texts with paths
+// "licenses/<ID>.txt" and "exceptions/<ID>.txt".
+type TextFS struct {
+	db *DB
+	// Text file names by directory, sorted
+	dirs map[string][]string
+	// Files of db by path in TextFS
+	files map[string]string
+}
+
+// TextFS returns license texts of db as fs.FS. Deprecated IDs are
+// listed without "deprecated_" prefix unless there is not deprecated
+// file with same ID. Directories are empty if db has no texts.
+func (db *DB) TextFS() *TextFS {
+	t := &TextFS{
+		db:    db,
+		dirs:  map[string][]string{LicensesDir: {}, ExceptionsDir: {}},
+		files: make(map[string]string),
+	}
+	if db.noText {
+		return t
+	}
+	for _, file := range db.Filenames {
+		id := strings.TrimPrefix(file, "deprecated_")
+		if id != file {
+			if _, ok := db.Files[id]; ok {
+				continue
+			}
+		}
+		dir := LicensesDir
+		if db.IsException(id) {
+			dir = ExceptionsDir
+		}
+		name := id + ".txt"
+		t.dirs[dir] = append(t.dirs[dir], name)
+		t.files[path.Join(dir, name)] = file
+	}
+	for _, names := range t.dirs {
+		slices.Sort(names)
+	}
+	return t
+}
+
+func (t *TextFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	if name == "." {
+		return &textDir{info: dirInfo("."), entries: []fs.DirEntry{
+			fs.FileInfoToDirEntry(dirInfo(ExceptionsDir)),
+			fs.FileInfoToDirEntry(dirInfo(LicensesDir)),
+		}}, nil
+	}
+	if names, ok := t.dirs[name]; ok {
+		entries := make([]fs.DirEntry, len(names))
+		for i, n := range names {
+			entries[i] = textDirEntry{t, path.Join(name, n)}
+		}
+		return &textDir{info: dirInfo(name), entries: entries}, nil
+	}
+	file, ok := t.files[name]
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	// Whole text is read, so files support Seek and ReadAt needed to
+	// serve them with http.FileServerFS
+	text, err := t.db.ReadText(file)
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+	info := fileInfo{path.Base(name), int64(len(text)), false}
+	return &textFile{strings.NewReader(text), info}, nil
+}
+
+// stat returns info of text file with size of underlying file
+func (t *TextFS) stat(name string) (fs.FileInfo, error) {
+	info, err := fs.Stat(t.db.fsys, t.db.Files[t.files[name]])
+	if err != nil {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: err}
+	}
+	return fileInfo{path.Base(name), info.Size(), false}, nil
+}
+
+type fileInfo struct {
+	name  string
+	size  int64
+	isDir bool
+}
+
+func dirInfo(name string) fileInfo {
+	return fileInfo{name, 0, true}
+}
+
+func (i fileInfo) Name() string       { return i.name }
+func (i fileInfo) Size() int64        { return i.size }
+func (i fileInfo) ModTime() time.Time { return time.Time{} }
+func (i fileInfo) IsDir() bool        { return i.isDir }
+func (i fileInfo) Sys() any           { return nil }
+
+func (i fileInfo) Mode() fs.FileMode {
+	if i.isDir {
+		return fs.ModeDir | 0o555
+	}
+	return 0o444
+}
+
+// Text file that implements io.Seeker and io.ReaderAt
+type textFile struct {
+	*strings.Reader
+	info fs.FileInfo
+}
+
+func (f *textFile) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *textFile) Close() error {
+	return nil
+}
+
+// Entry of text directory that stats underlying file on demand
+type textDirEntry struct {
+	t    *TextFS
+	path string
+}
+
+func (e textDirEntry) Name() string               { return path.Base(e.path) }
+func (e textDirEntry) IsDir() bool                { return false }
+func (e textDirEntry) Type() fs.FileMode          { return 0 }
+func (e textDirEntry) Info() (fs.FileInfo, error) { return e.t.stat(e.path) }
+
+type textDir struct {
+	info    fileInfo
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (d *textDir) Stat() (fs.FileInfo, error) {
+	return d.info, nil
+}
+
+func (d *textDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: fs.ErrInvalid}
+}
+
+func (d *textDir) Close() error {
+	return nil
+}
+
+func (d *textDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	rest := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return slices.Clone(rest), nil
+	}
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+	rest = rest[:min(n, len(rest))]
+	d.offset += len(rest)
+	return slices.Clone(rest), nil
+}
@@ -0,0 +1,41 @@
+package internal_test
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+func Test_TextFS(t *testing.T) {
+	t.Parallel()
+	fsys := fixtureDB(t).TextFS()
+	if err := fstest.TestFS(fsys,
+		"licenses/MIT.txt",
+		"licenses/GPL-2.0.txt",
+		"licenses/GPL-2.0-only.txt",
+		"exceptions/Classpath-exception-2.0.txt",
+	); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := fs.ReadFile(fsys, "licenses/GPL-2.0.txt"); err != nil || string(data) != "GPL 2" {
+		t.Fatalf("ReadFile(licenses/GPL-2.0.txt) = %q, %v; want GPL 2", data, err)
+	}
+	if info, err := fs.Stat(fsys, "licenses/MIT.txt"); err != nil || info.Size() != int64(len("MIT License")) {
+		t.Fatalf("Stat(licenses/MIT.txt) = %v, %v; want size %v", info, err, len("MIT License"))
+	}
+	if _, err := fsys.Open("licenses/Classpath-exception-2.0.txt"); err == nil {
+		t.Fatalf("Open(licenses/Classpath-exception-2.0.txt) succeeded; want error")
+	}
+}
+
+func Test_TextFSEmbedded(t *testing.T) {
+	t.Parallel()
+	if !internal.Default().HasTexts() {
+		t.Skip("built without license texts")
+	}
+	if err := fstest.TestFS(internal.Default().TextFS(), "licenses/MIT.txt", "exceptions/LLVM-exception.txt"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,62 @@
+package internal
+
+// Byte-wise prefix tree mapping lowercase keys to sets of IDs
+type Trie struct {
+	root trieNode
+}
+
+type trieNode struct {
+	children map[byte]*trieNode
+	ids      []string
+}
+
+func (t *Trie) Insert(key, id string) {
+	node := &t.root
+	for i := 0; i < len(key); i++ {
+		child, ok := node.children[key[i]]
+		if !ok {
+			if node.children == nil {
+				node.children = make(map[byte]*trieNode)
+			}
+			child = &trieNode{}
+			node.children[key[i]] = child
+		}
+		node = child
+	}
+	for _, existing := range node.ids {
+		if existing == id {
+			return
+		}
+	}
+	node.ids = append(node.ids, id)
+}
+
+// WithPrefix returns all IDs stored under keys starting with prefix mapped
+// to the length of the shortest such key minus length of prefix.
+func (t *Trie) WithPrefix(prefix string) map[string]int {
+	node := &t.root
+	for i := 0; i < len(prefix); i++ {
+		child, ok := node.children[prefix[i]]
+		if !ok {
+			return map[string]int{}
+		}
+		node = child
+	}
+	found := make(map[string]int)
+	level := []*trieNode{node}
+	for depth := 0; len(level) > 0; depth++ {
+		next := make([]*trieNode, 0)
+		for _, n := range level {
+			for _, id := range n.ids {
+				if _, ok := found[id]; !ok {
+					found[id] = depth
+				}
+			}
+			for _, child := range n.children {
+				next = append(next, child)
+			}
+		}
+		level = next
+	}
+	return found
+}
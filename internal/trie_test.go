@@ -0,0 +1,39 @@
+package internal_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+func Test_TrieWithPrefix(t *testing.T) {
+	trie := &internal.Trie{}
+	trie.Insert("gpl", "GPL-2.0-only")
+	trie.Insert("gpl", "GPL-3.0-only")
+	trie.Insert("gpl3", "GPL-3.0-only")
+	trie.Insert("gpl-3.0-only", "GPL-3.0-only")
+	trie.Insert("mit", "MIT")
+
+	tests := []struct {
+		prefix string
+		want   map[string]int
+	}{
+		{"", map[string]int{"GPL-2.0-only": 3, "GPL-3.0-only": 3, "MIT": 3}},
+		{"gp", map[string]int{"GPL-2.0-only": 1, "GPL-3.0-only": 1}},
+		{"gpl3", map[string]int{"GPL-3.0-only": 0}},
+		{"gpl-", map[string]int{"GPL-3.0-only": 8}},
+		{"x", map[string]int{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.prefix, func(t *testing.T) {
+			t.Parallel()
+
+			got := trie.WithPrefix(tc.prefix)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("WithPrefix(%v) = %v; want %v", tc.prefix, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package internal
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Widely used license URLs, in NormaliseURL form
+var CommonURLs = map[string][]string{
+	"apache.org/licenses/license-2.0":                     {"Apache-2.0"},
+	"gnu.org/licenses/gpl-2.0":                            {"GPL-2.0-only", "GPL-2.0-or-later"},
+	"gnu.org/licenses/old-licenses/gpl-2.0":               {"GPL-2.0-only", "GPL-2.0-or-later"},
+	"gnu.org/licenses/gpl-3.0":                            {"GPL-3.0-only", "GPL-3.0-or-later"},
+	"gnu.org/licenses/old-licenses/lgpl-2.1":              {"LGPL-2.1-only", "LGPL-2.1-or-later"},
+	"gnu.org/licenses/lgpl-3.0":                           {"LGPL-3.0-only", "LGPL-3.0-or-later"},
+	"gnu.org/licenses/agpl-3.0":                           {"AGPL-3.0-only", "AGPL-3.0-or-later"},
+	"mozilla.org/mpl/2.0":                                 {"MPL-2.0"},
+	"eclipse.org/legal/epl-v10":                           {"EPL-1.0"},
+	"eclipse.org/legal/epl-2.0":                           {"EPL-2.0"},
+	"boost.org/license_1_0":                               {"BSL-1.0"},
+	"unlicense.org":                                       {"Unlicense"},
+	"creativecommons.org/publicdomain/zero/1.0/legalcode": {"CC0-1.0"},
+}
+
+// Hosts whose URLs end with license ID, like https://spdx.org/licenses/MIT.html
+var idURLPrefixes = []string{
+	"spdx.org/licenses/",
+	"opensource.org/licenses/",
+	"opensource.org/license/",
+	"choosealicense.com/licenses/",
+}
+
+// NormaliseURL reduces URL to lowercase host and path without scheme,
+// "www." prefix, query, trailing slash and .txt/.html extension.
+// Example: "http://www.apache.org/licenses/LICENSE-2.0.txt" ->
+// "apache.org/licenses/license-2.0"
+func NormaliseURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	path := strings.ToLower(strings.TrimRight(u.Path, "/"))
+	for _, ext := range []string{".txt", ".html", ".htm", ".php", ".json"} {
+		path = strings.TrimSuffix(path, ext)
+	}
+	return strings.TrimRight(host+path, "/")
+}
+
+// idFromURL extracts license ID from URLs like spdx.org/licenses/<ID>.
+// New-style OSI URLs like opensource.org/license/apache-2-0 are handled too.
+func (db *DB) idFromURL(normalised string) (string, bool) {
+	for _, prefix := range idURLPrefixes {
+		segment, ok := strings.CutPrefix(normalised, prefix)
+		if !ok || segment == "" || strings.Contains(segment, "/") {
+			continue
+		}
+		if id, ok := db.exactID(segment); ok {
+			return id, true
+		}
+		if file, ok := db.ResolveFile(segment); ok {
+			return strings.TrimPrefix(file, "deprecated_"), true
+		}
+		// "apache-2-0" -> "apache-2.0"
+		dotted := []byte(segment)
+		for i := 1; i+1 < len(dotted); i++ {
+			if dotted[i] == '-' && isVersion(string(dotted[i-1])) && isVersion(string(dotted[i+1])) {
+				dotted[i] = '.'
+			}
+		}
+		if file, ok := db.ResolveFile(string(dotted)); ok {
+			return strings.TrimPrefix(file, "deprecated_"), true
+		}
+	}
+	return "", false
+}
+
+// Index of normalised license URLs
+type URLIndex struct {
+	urls map[string]map[string]float64
+	db   *DB
+}
+
+func (idx *URLIndex) add(raw, id string, score float64) {
+	key := NormaliseURL(raw)
+	if key == "" {
+		return
+	}
+	if idx.urls[key] == nil {
+		idx.urls[key] = make(map[string]float64)
+	}
+	idx.urls[key][id] = max(idx.urls[key][id], score)
+}
+
+// NewURLIndex indexes seeAlso cross references from meta and CommonURLs.
+// IDs in URLs like spdx.org/licenses/<ID> are resolved with db unless
+// it is nil.
+func NewURLIndex(db *DB, meta map[string]*Meta) *URLIndex {
+	idx := &URLIndex{make(map[string]map[string]float64), db}
+	for id, m := range meta {
+		score := 1.0
+		if m.Deprecated {
+			score = 0.9
+		}
+		for _, u := range m.SeeAlso {
+			idx.add(u, id, score)
+		}
+	}
+	for u, ids := range CommonURLs {
+		for _, id := range ids {
+			idx.add(u, id, 0.95)
+		}
+	}
+	return idx
+}
+
+// Match returns candidate IDs for license URL, best first.
+func (idx *URLIndex) Match(raw string) []Candidate {
+	key := NormaliseURL(raw)
+	best := make(map[string]float64)
+	if idx.db != nil {
+		if id, ok := idx.db.idFromURL(key); ok {
+			best[id] = 1
+		}
+	}
+	for id, score := range idx.urls[key] {
+		best[id] = max(best[id], score)
+	}
+	candidates := make([]Candidate, 0, len(best))
+	for id, score := range best {
+		candidates = append(candidates, Candidate{id, score})
+	}
+	sortCandidates(candidates)
+	return candidates
+}
+
+// FromURL returns candidate IDs for license URL, best first.
+func (db *DB) FromURL(raw string) []Candidate {
+	return db.urls().Match(raw)
+}
@@ -0,0 +1,67 @@
+package internal_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+func Test_NormaliseURL(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"http://www.apache.org/licenses/LICENSE-2.0.txt", "apache.org/licenses/license-2.0"},
+		{"https://apache.org/licenses/LICENSE-2.0/", "apache.org/licenses/license-2.0"},
+		{"https://spdx.org/licenses/GPL-3.0-or-later.html", "spdx.org/licenses/gpl-3.0-or-later"},
+		{"opensource.org/licenses/MIT?ref=x#top", "opensource.org/licenses/mit"},
+		{"https://unlicense.org/", "unlicense.org"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			got := internal.NormaliseURL(tc.in)
+			if got != tc.want {
+				t.Fatalf("NormaliseURL(%v) = %v; want %v", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func Test_URLIndexMatch(t *testing.T) {
+	idx := internal.NewURLIndex(internal.Default(), map[string]*internal.Meta{
+		"Zlib": {ID: "Zlib", SeeAlso: []string{"http://www.zlib.net/zlib_license.html"}},
+		"MIT":  {ID: "MIT", SeeAlso: []string{"https://opensource.org/license/mit/"}},
+	})
+
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"https://zlib.net/zlib_license.html", []string{"Zlib"}},
+		{"http://www.zlib.net/zlib_license", []string{"Zlib"}},
+		{"https://opensource.org/licenses/MIT", []string{"MIT"}},
+		{"https://opensource.org/license/apache-2-0", []string{"Apache-2.0"}},
+		{"https://spdx.org/licenses/GPL-3.0-or-later.html", []string{"GPL-3.0-or-later"}},
+		{"https://spdx.org/licenses/GPL-2.0+.json", []string{"GPL-2.0+"}},
+		{"http://www.gnu.org/licenses/gpl-3.0.txt", []string{"GPL-3.0-or-later", "GPL-3.0-only"}},
+		{"https://example.com/LICENSE", []string{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			got := make([]string, 0)
+			for _, c := range idx.Match(tc.in) {
+				got = append(got, c.ID)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("Match(%v) = %v; want %v", tc.in, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package internal
+
+import (
+	"archive/zip"
+	"bytes"
+	"cmp"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// CompareVersions compares dot separated numeric versions like "3.27.0".
+// Missing components are treated as zeros, so "3.27" equals "3.27.0".
+func CompareVersions(a, b string) int {
+	pa, pb := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var na, nb int
+		if i < len(pa) {
+			na, _ = strconv.Atoi(pa[i])
+		}
+		if i < len(pb) {
+			nb, _ = strconv.Atoi(pb[i])
+		}
+		if na != nb {
+			return cmp.Compare(na, nb)
+		}
+	}
+	return 0
+}
+
+// MatchVersion returns newest of versions that is equal to v or starts
+// with v followed by dot. Example: "3.24" matches "3.24.0".
+func MatchVersion(versions []string, v string) (string, bool) {
+	v = strings.TrimPrefix(v, "v")
+	best := ""
+	for _, candidate := range versions {
+		if candidate != v && !strings.HasPrefix(candidate, v+".") {
+			continue
+		}
+		if best == "" || CompareVersions(candidate, best) > 0 {
+			best = candidate
+		}
+	}
+	return best, best != ""
+}
+
+// Archive with texts of all embedded versions, each stored once.
+// Archives of versions have no texts and are used with it.
+const BlobsArchive = "blobs.zip"
+
+// blobsFS serves texts from archive shared by all embedded versions and
+// other files from archive of one version.
+type blobsFS struct {
+	fs.FS
+	blobs fs.FS
+}
+
+func (f blobsFS) Open(name string) (fs.File, error) {
+	if strings.HasPrefix(name, indexedLayout.dir+"/") {
+		return f.blobs.Open(name)
+	}
+	return f.FS.Open(name)
+}
+
+func archiveName(version string) string {
+	return path.Join(archiveDir, "spdx"+version+".zip")
+}
+
+// EmbeddedVersions returns versions of embedded license lists,
+// oldest first.
+var EmbeddedVersions = sync.OnceValue(func() []string {
+	names, err := fs.Glob(archives, archiveName("*"))
+	if err != nil {
+		// There should not be errors while working with embedded archive
+		panic(err)
+	}
+	versions := make([]string, len(names))
+	for i, name := range names {
+		versions[i] = strings.TrimSuffix(strings.TrimPrefix(path.Base(name), "spdx"), ".zip")
+	}
+	slices.SortFunc(versions, CompareVersions)
+	return versions
+})
+
+// Embedded builds database for embedded license list version like
+// "3.24" or "3.24.0". Every call returns a new database, so aliases and
+// text cache of one are not seen by others, like Default.
+func Embedded(version string) (*DB, error) {
+	v, ok := MatchVersion(EmbeddedVersions(), version)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownVersion, version)
+	}
+	db, err := openEmbedded(v)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", archiveName(v), err)
+	}
+	if db.ListVersion == "" {
+		// Archive was generated without metadata
+		db.ListVersion = v
+	}
+	return db, nil
+}
+
+// openEmbedded builds database from embedded archive of version v and,
+// unless built without texts, from shared BlobsArchive.
+func openEmbedded(v string) (*DB, error) {
+	data, err := archives.ReadFile(archiveName(v))
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	var fsys fs.FS = zr
+	if embeddedTexts {
+		blobs, err := sharedBlobs()
+		if err != nil {
+			return nil, err
+		}
+		fsys = blobsFS{zr, blobs}
+	}
+	db, err := NewDB(fsys)
+	if err != nil {
+		return nil, err
+	}
+	db.noText = !embeddedTexts
+	return db, nil
+}
+
+// sharedBlobs returns reader of embedded BlobsArchive.
+var sharedBlobs = sync.OnceValues(func() (fs.FS, error) {
+	data, err := archives.ReadFile(BlobsArchive)
+	if err != nil {
+		return nil, err
+	}
+	return zip.NewReader(bytes.NewReader(data), int64(len(data)))
+})
@@ -0,0 +1,126 @@
+package internal_test
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"maps"
+	"path/filepath"
+	"testing"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+func Test_CompareVersions(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"3.27.0", "3.27.0", 0},
+		{"3.27", "3.27.0", 0},
+		{"3.9", "3.27", -1},
+		{"3.27.1", "3.27", 1},
+		{"4", "3.27.0", 1},
+	}
+	for _, c := range cases {
+		t.Run(c.a+"_"+c.b, func(t *testing.T) {
+			t.Parallel()
+			if got := internal.CompareVersions(c.a, c.b); got != c.want {
+				t.Fatalf("CompareVersions(%v, %v) = %v; want %v", c.a, c.b, got, c.want)
+			}
+		})
+	}
+}
+
+func Test_MatchVersion(t *testing.T) {
+	t.Parallel()
+	versions := []string{"3.9.0", "3.24.0", "3.24.1", "3.27.0"}
+	cases := []struct {
+		version string
+		want    string
+		ok      bool
+	}{
+		{"3.24.0", "3.24.0", true},
+		{"3.24", "3.24.1", true},
+		{"v3.27", "3.27.0", true},
+		{"3", "3.27.0", true},
+		{"3.2", "", false},
+		{"3.24.2", "", false},
+	}
+	for _, c := range cases {
+		t.Run(c.version, func(t *testing.T) {
+			t.Parallel()
+			got, ok := internal.MatchVersion(versions, c.version)
+			if got != c.want || ok != c.ok {
+				t.Fatalf("MatchVersion(%v) = %v, %v; want %v, %v", c.version, got, ok, c.want, c.ok)
+			}
+		})
+	}
+}
+
+func Test_EmbeddedBlobs(t *testing.T) {
+	t.Parallel()
+	blobs := make(map[string]bool)
+	for _, v := range internal.EmbeddedVersions() {
+		name := filepath.Join("notext", "spdx"+v+".zip")
+		zr, err := zip.OpenReader(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		index := make(map[string]string)
+		for _, indexName := range []string{"index.json", internal.TemplatesFile} {
+			data, err := fs.ReadFile(zr, indexName)
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var m map[string]string
+			if err := json.Unmarshal(data, &m); err != nil {
+				t.Fatalf("%v: %v: %v", name, indexName, err)
+			}
+			for _, hash := range m {
+				blobs["blobs/"+hash] = true
+			}
+			if indexName == "index.json" {
+				index = m
+			}
+		}
+		zr.Close()
+
+		embedded, err := internal.Embedded(v)
+		if err != nil {
+			t.Fatalf("Embedded(%v) error: %v", v, err)
+		}
+		if !embedded.HasTexts() {
+			continue
+		}
+		// Texts are stored under their SHA-256
+		for file, hash := range index {
+			text, err := embedded.ReadText(file)
+			sum := sha256.Sum256([]byte(text))
+			if err != nil || hex.EncodeToString(sum[:]) != hash {
+				t.Fatalf("Embedded(%v).ReadText(%v) = %d bytes, %v; want text with SHA-256 %v", v, file, len(text), err, hash)
+			}
+		}
+	}
+
+	zr, err := zip.OpenReader(internal.BlobsArchive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer zr.Close()
+	shared := make(map[string]bool)
+	for _, f := range zr.File {
+		shared[f.Name] = true
+	}
+	if !maps.Equal(shared, blobs) {
+		t.Fatalf("%v has %d entries; want %d blobs of embedded versions, run go generate",
+			internal.BlobsArchive, len(shared), len(blobs))
+	}
+}
@@ -0,0 +1,137 @@
+package licensedb
+
+import (
+	"slices"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+// License describes SPDX license or license exception.
+// Fields other than ID, IsException and Deprecated are empty if the
+// database was generated without SPDX license list metadata.
+type License struct {
+	ID   string
+	Name string
+	// Group of related licenses, like "BSD" or "GPL"
+	Family      string
+	IsException bool
+	OSIApproved bool
+	FSFLibre    bool
+	Deprecated  bool
+	// Cross references to license text and related pages
+	SeeAlso []string
+	Comment string
+	// Standard header to put in source files, with placeholders like
+	// "[yyyy]". Use Header to fill them in.
+	Header string
+	// Licenses an exception is typically used with
+	RelatedLicenses []string
+	// Version of SPDX license list the license comes from
+	ListVersion string
+
+	// Archive file with license text
+	file string
+	db   *internal.DB
+}
+
+// Text returns full text of license or empty string if it can't be
+// read. Use DB.Text to get the error.
+func (l License) Text() string {
+	if l.db == nil {
+		return ""
+	}
+	text := l.db.GetText(l.file)
+	if text == nil {
+		return ""
+	}
+	return *text
+}
+
+func (db *DB) newLicense(m *internal.Meta) License {
+	file, _ := db.core.ResolveFile(m.ID)
+	return License{
+		ID:          m.ID,
+		Name:        m.Name,
+		Family:      internal.Family(m.ID),
+		IsException: m.IsException,
+		OSIApproved: m.OSIApproved,
+		FSFLibre:    m.FSFLibre,
+		Deprecated:  m.Deprecated,
+		SeeAlso:     slices.Clone(m.SeeAlso),
+		Comment:     m.Comment,
+		Header:      m.Header,
+
+		RelatedLicenses: slices.Clone(m.RelatedLicenses),
+		ListVersion:     db.core.ListVersion,
+
+		file: file,
+		db:   db.core,
+	}
+}
+
+// Lookup returns license or exception with SPDX id.
+// Alternative forms of ID like "gpl3+" are accepted too.
+func (db *DB) Lookup(id string) (License, bool) {
+	m, ok := db.core.GetMeta(id)
+	if !ok {
+		return License{}, false
+	}
+	return db.newLicense(m), true
+}
+
+// Lookup is a wrapper around Default().Lookup.
+func Lookup[S ~string](id S) (License, bool) {
+	return Default().Lookup(string(id))
+}
+
+// Exceptions returns all SPDX license exceptions sorted by ID.
+// If the database has no metadata, only ID, IsException, Deprecated
+// and RelatedLicenses are set; Name and other fields stay empty.
+func (db *DB) Exceptions() []License {
+	exceptions := make([]License, 0, len(db.core.ExceptionsList))
+	for _, id := range db.core.ExceptionsList {
+		if m, ok := db.core.GetMeta(id); ok {
+			exceptions = append(exceptions, db.newLicense(m))
+		}
+	}
+	return exceptions
+}
+
+// Exceptions is a wrapper around Default().Exceptions.
+func Exceptions() []License {
+	return Default().Exceptions()
+}
+
+func toCandidates(found []internal.Candidate) []Candidate {
+	candidates := make([]Candidate, len(found))
+	for i, c := range found {
+		candidates[i] = Candidate(c)
+	}
+	return candidates
+}
+
+// FromName returns candidate IDs for full license name like
+// "Apache License, Version 2.0" or "The MIT License", best first.
+// Both official SPDX names and widespread variants are recognised;
+// case, punctuation, "the" and "version"/"v" markers are ignored.
+func (db *DB) FromName(name string) []Candidate {
+	return toCandidates(db.core.FromName(name))
+}
+
+// FromName is a wrapper around Default().FromName.
+func FromName(name string) []Candidate {
+	return Default().FromName(name)
+}
+
+// FromURL returns candidate IDs for license URL, best first.
+// URLs are matched against seeAlso cross references of every license and
+// spdx.org/opensource.org URL patterns, ignoring scheme, "www.", trailing
+// slash and .txt/.html extension.
+func (db *DB) FromURL(url string) []Candidate {
+	return toCandidates(db.core.FromURL(url))
+}
+
+// FromURL is a wrapper around Default().FromURL.
+func FromURL(url string) []Candidate {
+	return Default().FromURL(url)
+}
@@ -1,28 +1,189 @@
+// Package licensedb is an embeddable database of SPDX licenses.
+//
+// Extract returns a fifth value, suggestions for unknown tokens, since
+// "did you mean" support was added; callers that ignore it write
+// "l, e, a, u, _ := licensedb.Extract(expr)". GetFiles returns an error as
+// its last value, which is set when a text can't be read.
 package licensedb
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
 	"slices"
 	"strings"
+	"sync"
 
 	"github.com/asciimoth/licensedb/internal"
 )
 
+var (
+	// ErrUnknownID is returned when an SPDX ID is not present in the database.
+	ErrUnknownID = internal.ErrUnknownID
+	// ErrAliasExists is returned when an alias collides with a known form.
+	ErrAliasExists = internal.ErrAliasExists
+	// ErrInvalidAlias is returned for empty aliases.
+	ErrInvalidAlias = internal.ErrInvalidAlias
+	// ErrNoLicenses is returned by Open when there are no license texts.
+	ErrNoLicenses = internal.ErrNoLicenses
+	// ErrUnknownVersion is returned by Version for versions that are not embedded.
+	ErrUnknownVersion = internal.ErrUnknownVersion
+	// ErrNoText is returned by functions returning license texts when
+	// package is built with licensedb_notext build tag.
+	ErrNoText = internal.ErrNoText
+	// ErrNoTemplate is returned by DB.Template when database has no
+	// template for license.
+	ErrNoTemplate = internal.ErrNoTemplate
+	// ErrInvalidTemplate is returned for templates with broken markup.
+	ErrInvalidTemplate = internal.ErrInvalidTemplate
+	// ErrNoHeader is returned by Header for licenses without standard
+	// header or when database has no metadata.
+	ErrNoHeader = internal.ErrNoHeader
+)
+
+// DB is a database of SPDX licenses and exceptions.
+// It is safe for concurrent use.
+type DB struct {
+	core *internal.DB
+}
+
+var defaultDB = sync.OnceValue(func() *DB {
+	return &DB{internal.Default()}
+})
+
+// Default returns database built from embedded SPDX license list.
+// It is built on first call, so programs that never use it don't pay
+// for loading the list. Top-level functions of the package use it.
+func Default() *DB {
+	return defaultDB()
+}
+
+// Versions returns versions of embedded SPDX license lists, oldest first.
+func Versions() []string {
+	return slices.Clone(internal.EmbeddedVersions())
+}
+
+// Version returns database built from embedded SPDX license list of
+// version v. Partial versions like "3.24" select newest matching one.
+// Every call builds a new database with its own registered aliases and
+// text cache, separate from Default even for the same version, so keep
+// the result instead of calling Version repeatedly.
+func Version(v string) (*DB, error) {
+	core, err := internal.Embedded(v)
+	if err != nil {
+		return nil, err
+	}
+	return &DB{core}, nil
+}
+
+// ListVersion returns version of SPDX license list db was built from,
+// like "3.27.0". It is empty if unknown.
+func (db *DB) ListVersion() string {
+	return db.core.ListVersion
+}
+
+// Open builds database from license data in fsys. Both checkout of
+// license-list-data repository (text/<ID>.txt files and json/ directory),
+// optionally wrapped in single top-level directory like in its release
+// archives, and layout of the embedded archive are supported.
+// Metadata is optional; without it fields of License other than ID,
+// IsException and Deprecated are empty. License templates are read from
+// template/ directory if there is one.
+func Open(fsys fs.FS) (*DB, error) {
+	core, err := internal.NewDB(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &DB{core}, nil
+}
+
+// OpenZip reads zip archive at path into memory and builds database from
+// it like Open does.
+func OpenZip(path string) (*DB, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	core, err := internal.NewZipDB(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &DB{core}, nil
+}
+
+// RegisterAlias registers from as an exact alternative spelling of the to
+// SPDX ID (or ambiguous short form like "GPL-3.0"). Aliases are
+// case-insensitive and may consist of several words, like "ASL 2.0".
+// It is safe to call concurrently with other methods of db.
+func (db *DB) RegisterAlias(from, to string) error {
+	return db.core.RegisterAlias(from, to)
+}
+
+// RegisterAlias is a wrapper around Default().RegisterAlias.
+func RegisterAlias(from, to string) error {
+	return Default().RegisterAlias(from, to)
+}
+
+// Alias is a real-world spelling of SPDX ID or ambiguous short form.
+type Alias struct {
+	// Lowercase spelling, words are separated by single spaces
+	From string
+	To   string
+	// Lossy aliases guess details the spelling doesn't carry and are
+	// applied only with WithLossyAliases option
+	Lossy bool
+}
+
+// Aliases returns built-in and registered aliases.
+func (db *DB) Aliases() []Alias {
+	aliases := db.core.ListAliases()
+	out := make([]Alias, len(aliases))
+	for i, a := range aliases {
+		out[i] = Alias{a.From, a.To, a.Lossy}
+	}
+	return out
+}
+
+// Aliases is a wrapper around Default().Aliases.
+func Aliases() []Alias {
+	return Default().Aliases()
+}
+
 // Normalise converts alternative forms of SPDX IDs in text to their normal form.
-func Normalise(text string) string {
+func (db *DB) Normalise(text string, opts ...Option) string {
+	o := newOptions(opts)
 	// BUG: just `strings.Join(Tokenise(text), " ")` returns string with extra whitespaces
-	text = strings.Join(internal.Tokenise(text), " ")
+	text = strings.Join(db.core.TokeniseWith(text, o.profile), " ")
 	return strings.Join(strings.Fields(text), " ")
 }
 
-// ToShortForms converts SPDX IDs in text to their alternative short names.
-func ToShortForms(text string) []string {
+// Normalise is a wrapper around Default().Normalise.
+func Normalise(text string, opts ...Option) string {
+	return Default().Normalise(text, opts...)
+}
+
+// Complete returns up to limit license and exception IDs matching typed
+// prefix of ID or of its alternative form, ranked by exactness and
+// popularity. Non-positive limit means no limit.
+func (db *DB) Complete(prefix string, limit int) []string {
+	return db.core.Complete(prefix, limit)
+}
+
+// Complete is a wrapper around Default().Complete.
+func Complete(prefix string, limit int) []string {
+	return Default().Complete(prefix, limit)
+}
+
+// ToShortForms converts SPDX IDs in text to their alternative short names,
+// shortest first.
+func (db *DB) ToShortForms(text string) []string {
 	forms := []string{}
-	for k, v := range internal.Globs {
-		if !slices.Contains(v, text) {
-			continue
-		}
+	for _, k := range db.core.GlobsOf(text) {
 		if len(k) >= len(text) {
-			continue
+			break
 		}
 		if strings.HasSuffix(k, "-") {
 			continue
@@ -38,27 +199,64 @@ func ToShortForms(text string) []string {
 	return forms
 }
 
+// ToShortForms is a wrapper around Default().ToShortForms.
+func ToShortForms[S ~string](text S) []string {
+	return Default().ToShortForms(string(text))
+}
+
+// Candidate is a possible meaning of a token with score in (0, 1],
+// higher is better.
+type Candidate struct {
+	ID    string
+	Score float64
+}
+
+// Number of suggestions reported by Extract for each unknown token
+const extractSuggestions = 3
+
+// Suggest returns up to limit known IDs and short forms similar to
+// misspelled token, best first. Non-positive limit means no limit.
+func (db *DB) Suggest(token string, limit int) []Candidate {
+	return toCandidates(db.core.Suggest(token, limit))
+}
+
+// Suggest is a wrapper around Default().Suggest.
+func Suggest(token string, limit int) []Candidate {
+	return Default().Suggest(token, limit)
+}
+
 // Extract extratcs SPDX IDs from text expression.
-func Extract(expr string) (licenses, exceptions, ambiguous, unknown []string) {
-	tokens := internal.Tokenise(expr)
+// For each unknown token it also reports "did you mean" suggestions;
+// this fifth result was added after the first release, so older callers
+// need an extra blank identifier.
+func (db *DB) Extract(expr string, opts ...Option) (
+	licenses, exceptions, ambiguous, unknown []string,
+	suggestions map[string][]Candidate,
+) {
+	o := newOptions(opts)
+	tokens := db.core.TokeniseWith(expr, o.profile)
 	licenses = make([]string, 0, len(tokens))
 	exceptions = make([]string, 0, len(tokens))
 	ambiguous = make([]string, 0, len(tokens))
 	unknown = make([]string, 0, len(tokens))
+	suggestions = make(map[string][]Candidate)
 	for _, token := range tokens {
 		token = strings.TrimSpace(token)
 		if token == "" || token == " " || slices.Contains(internal.Keywords, token) {
 			continue
 		}
-		if _, ok := internal.Files[token]; !ok {
-			if _, ok := internal.Globs[token]; ok {
+		if _, ok := db.core.Files[token]; !ok {
+			if _, ok := db.core.Globs[token]; ok {
 				ambiguous = append(ambiguous, token)
 				continue
 			}
 			unknown = append(unknown, token)
+			if found := db.Suggest(token, extractSuggestions); len(found) > 0 {
+				suggestions[token] = found
+			}
 			continue
 		}
-		if slices.Contains(internal.ExceptionsList, token) {
+		if db.core.IsException(token) {
 			exceptions = append(exceptions, token)
 			continue
 		}
@@ -67,11 +265,24 @@ func Extract(expr string) (licenses, exceptions, ambiguous, unknown []string) {
 	return
 }
 
+// Extract is a wrapper around Default().Extract.
+func Extract(expr string, opts ...Option) (
+	licenses, exceptions, ambiguous, unknown []string,
+	suggestions map[string][]Candidate,
+) {
+	return Default().Extract(expr, opts...)
+}
+
 // AreMatching reports if two expressions contains same sets of licenses and exceptions.
+func (db *DB) AreMatching(a, b string) bool {
+	ta := db.core.Tokenise(a)
+	tb := db.core.Tokenise(b)
+	return db.core.AreTokensListsMatchingSwap(ta, tb)
+}
+
+// AreMatching is a wrapper around Default().AreMatching.
 func AreMatching(a, b string) bool {
-	ta := internal.Tokenise(a)
-	tb := internal.Tokenise(b)
-	return internal.AreTokensListsMatchingSwap(ta, tb)
+	return Default().AreMatching(a, b)
 }
 
 // License/exception text file
@@ -81,39 +292,171 @@ type File struct {
 }
 
 // Return list of files for licenses/exceptions found in provided expression.
-func GetFiles(expr string) (
+// IDs sharing text with ID met earlier, like "GPL-3.0-or-later" after
+// "GPL-3.0-only", don't get files of their own.
+// Error is returned if some text can't be read.
+func (db *DB) GetFiles(expr string, opts ...Option) (
 	licenses map[string]File,
 	exceptions map[string]File,
 	unknown []string,
+	err error,
 ) {
 	licenses = make(map[string]File)
 	exceptions = make(map[string]File)
 	unknown = make([]string, 0)
 
-	tokens := internal.Tokenise(expr)
+	o := newOptions(opts)
+	tokens := db.core.TokeniseWith(expr, o.profile)
 	for i := range len(tokens) {
 		if slices.Contains(internal.Keywords, tokens[i]) {
 			continue
 		}
-		if _, ok := internal.Globs[tokens[i]]; ok {
-			tokens[i] = internal.GlobToFirstMatch(tokens[i])
+		if _, ok := db.core.Globs[tokens[i]]; ok {
+			tokens[i] = db.core.GlobToFirstMatch(tokens[i])
 		}
 	}
-	mapping := internal.TokensToShort(tokens)
+	mapping := db.core.TokensToShort(tokens)
+	emitted := make([]string, 0, len(tokens))
 	for _, token := range tokens {
 		if slices.Contains(internal.Keywords, token) {
 			continue
 		}
-		text := internal.GetText(token)
-		if text == nil {
+		text, err := db.core.ReadText(token)
+		if errors.Is(err, internal.ErrUnknownID) {
 			unknown = append(unknown, token)
 			continue
 		}
-		if slices.Contains(internal.ExceptionsList, token) {
-			exceptions[token] = File{*text, mapping[token]}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if slices.ContainsFunc(emitted, func(e string) bool {
+			return db.core.SameText(e, token)
+		}) {
+			continue
+		}
+		emitted = append(emitted, token)
+		if db.core.IsException(token) {
+			exceptions[token] = File{text, mapping[token]}
 			continue
 		}
-		licenses[token] = File{*text, mapping[token]}
+		licenses[token] = File{text, mapping[token]}
 	}
 	return
 }
+
+// GetFiles is a wrapper around Default().GetFiles.
+func GetFiles(expr string, opts ...Option) (
+	licenses map[string]File,
+	exceptions map[string]File,
+	unknown []string,
+	err error,
+) {
+	return Default().GetFiles(expr, opts...)
+}
+
+// SameText reports whether licenses or exceptions a and b have identical
+// texts, like "GPL-3.0-only" and "GPL-3.0-or-later". Alternative forms
+// of IDs are accepted.
+func (db *DB) SameText(a, b string) bool {
+	fa, okA := db.core.ResolveFile(a)
+	fb, okB := db.core.ResolveFile(b)
+	return okA && okB && db.core.SameText(fa, fb)
+}
+
+// SameText is a wrapper around Default().SameText.
+func SameText[A, B ~string](a A, b B) bool {
+	return Default().SameText(string(a), string(b))
+}
+
+// OpenText opens text of license or exception id for streaming.
+// Alternative forms of ID like "gpl3+" are accepted.
+func (db *DB) OpenText(id string) (io.ReadCloser, error) {
+	file, ok := db.core.ResolveFile(id)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownID, id)
+	}
+	return db.core.OpenText(file)
+}
+
+// OpenText is a wrapper around Default().OpenText.
+func OpenText[S ~string](id S) (io.ReadCloser, error) {
+	return Default().OpenText(string(id))
+}
+
+// Text returns text of license or exception id.
+// Alternative forms of ID like "gpl3+" are accepted.
+func (db *DB) Text(id string) (string, error) {
+	file, ok := db.core.ResolveFile(id)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownID, id)
+	}
+	return db.core.ReadText(file)
+}
+
+// Text is a wrapper around Default().Text.
+func Text[S ~string](id S) (string, error) {
+	return Default().Text(string(id))
+}
+
+// HasTexts reports whether db has license texts. Embedded databases
+// don't have them when package is built with licensedb_notext build tag;
+// then functions returning texts fail with ErrNoText.
+func (db *DB) HasTexts() bool {
+	return db.core.HasTexts()
+}
+
+// HasMetadata reports whether db has SPDX license list metadata like
+// names, OSI approval and cross references. Archives without json/
+// directory don't have it; then fields of License other than ID,
+// IsException, Deprecated and RelatedLicenses are empty, and FromName
+// and FromURL only know curated names and URLs.
+func (db *DB) HasMetadata() bool {
+	return db.core.HasMetadata()
+}
+
+// SetTextCacheSize enables cache of texts returned by Text, GetFiles and FS
+// limited to size bytes in total, least recently used texts are dropped
+// first. Zero disables cache, which is the default.
+func (db *DB) SetTextCacheSize(size int) {
+	db.core.SetTextCacheSize(size)
+}
+
+// FS returns read-only file system of license and exception texts with
+// paths "licenses/<ID>.txt" and "exceptions/<ID>.txt". It can be served
+// with http.FileServerFS, including range requests, or walked with
+// fs.WalkDir. Opened files implement io.Seeker and io.ReaderAt and use
+// text cache of SetTextCacheSize. Directories are empty if db has no
+// texts, see HasTexts.
+func (db *DB) FS() fs.FS {
+	return db.core.TextFS()
+}
+
+// FS is a wrapper around Default().FS.
+func FS() fs.FS {
+	return Default().FS()
+}
+
+// Manifest describes license list archive database was built from.
+type Manifest struct {
+	// Version of SPDX license list
+	ListVersion string
+	// Base name of release archive or checkout archive was generated
+	// from and SHA-256 of the release archive. Both may be empty.
+	Source       string
+	SourceSHA256 string
+	// Number of text files and of unique texts among them
+	Files int
+	Texts int
+	// Number of license templates
+	Templates int
+	// SHA-256 of text of each file, like "deprecated_GPL-2.0"
+	Hashes map[string]string
+}
+
+// Manifest returns manifest of archive db was built from. Embedded
+// databases always have one; databases opened from license-list-data
+// releases or checkouts don't.
+func (db *DB) Manifest() (Manifest, bool) {
+	m, ok := db.core.Manifest()
+	return Manifest{m.ListVersion, m.Source, m.SourceSHA256, m.Files, m.Texts, m.Templates, m.Hashes}, ok
+}
@@ -1,9 +1,24 @@
 package licensedb_test
 
 import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
 	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"testing/fstest"
 
 	"github.com/asciimoth/licensedb"
 )
@@ -77,19 +92,31 @@ func Test_ToShortForms(t *testing.T) {
 
 func Test_Extract(t *testing.T) {
 	tests := []struct {
-		in         string
-		licenses   []string
-		exceptions []string
-		ambiguous  []string
-		unknown    []string
+		in          string
+		licenses    []string
+		exceptions  []string
+		ambiguous   []string
+		unknown     []string
+		suggestions map[string][]string
 	}{
-		{"", []string{}, []string{}, []string{}, []string{}},
+		{"", []string{}, []string{}, []string{}, []string{}, map[string][]string{}},
 		{
 			"bsd  fdsfsadf GpL2  Or  \n  aSl20  aNd  gPl-3.0-wIth-autOconf-excEption",
 			[]string{"Apache-2.0", "GPL-3.0-or-later"},
 			[]string{"Autoconf-exception-3.0"},
 			[]string{"BSD", "GPL-2.0"},
 			[]string{"fdsfsadf"},
+			map[string][]string{},
+		},
+		{
+			"BSD3-Clause OR LGPL2.1+",
+			[]string{"LGPL-2.1-or-later"},
+			[]string{},
+			[]string{},
+			[]string{"bsd3-clause"},
+			map[string][]string{
+				"bsd3-clause": {"BSD-3-Clause", "BSD-2-Clause", "BSD-1-Clause"},
+			},
 		},
 	}
 
@@ -97,23 +124,59 @@ func Test_Extract(t *testing.T) {
 		t.Run(tc.in, func(t *testing.T) {
 			t.Parallel()
 
-			l, e, a, u := licensedb.Extract(tc.in)
+			l, e, a, u, s := licensedb.Extract(tc.in)
+			suggestions := make(map[string][]string, len(s))
+			for token, candidates := range s {
+				for _, c := range candidates {
+					suggestions[token] = append(suggestions[token], c.ID)
+				}
+			}
 			same := reflect.DeepEqual(l, tc.licenses) &&
 				reflect.DeepEqual(e, tc.exceptions) &&
 				reflect.DeepEqual(a, tc.ambiguous) &&
-				reflect.DeepEqual(u, tc.unknown)
+				reflect.DeepEqual(u, tc.unknown) &&
+				reflect.DeepEqual(suggestions, tc.suggestions)
 			if !same {
 				t.Fatalf(
-					"Extract(%v) = %v %v %v %v; want %v %v %v %v",
+					"Extract(%v) = %v %v %v %v %v; want %v %v %v %v %v",
 					tc.in,
-					l, e, a, u,
-					tc.licenses, tc.exceptions, tc.ambiguous, tc.unknown,
+					l, e, a, u, suggestions,
+					tc.licenses, tc.exceptions, tc.ambiguous, tc.unknown, tc.suggestions,
 				)
 			}
 		})
 	}
 }
 
+func Test_Suggest(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"Apache-2", "Apache-2.0"},
+		{"BSD3-Clause", "BSD-3-Clause"},
+		{"GLP-3.0", "GPL-3.0"},
+		{"LGPL2.1+", "LGPL-2.1-or-later"},
+		{"GPL-3.0-or-latter", "GPL-3.0-or-later"},
+		{"MTI", "MIT"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			got := licensedb.Suggest(tc.in, 1)
+			if len(got) != 1 || got[0].ID != tc.want {
+				t.Fatalf("Suggest(%v, 1) = %v; want %v", tc.in, got, tc.want)
+			}
+		})
+	}
+
+	if got := licensedb.Suggest("fdsfsadf", 0); len(got) != 0 {
+		t.Fatalf("Suggest(fdsfsadf, 0) = %v; want none", got)
+	}
+}
+
 func Test_AreMatching(t *testing.T) {
 	tests := []struct {
 		a    string
@@ -145,3 +208,1145 @@ func Test_AreMatching(t *testing.T) {
 		})
 	}
 }
+
+// privateDB returns database of default license list that is not shared
+// with other tests, so aliases registered in it don't leak
+func privateDB(t *testing.T) *licensedb.DB {
+	t.Helper()
+	db, err := licensedb.Version(licensedb.Default().ListVersion())
+	if err != nil {
+		t.Fatalf("Version(%v) error: %v", licensedb.Default().ListVersion(), err)
+	}
+	return db
+}
+
+func Test_RegisterAlias(t *testing.T) {
+	db := privateDB(t)
+	tests := []struct {
+		from string
+		to   string
+		want error
+	}{
+		{"asl-2", "Apache-2.0", nil},
+		{"ASL-2", "Apache-2.0", licensedb.ErrAliasExists},
+		{"bsd-new", "BSD-3-Clause", licensedb.ErrAliasExists},
+		{"gplv3", "GPL-3.0", licensedb.ErrAliasExists},
+		{"bsd", "BSD-3-Clause", licensedb.ErrAliasExists},
+		{"corp  BSD", "BSD-3-Clause", nil},
+		{"Corp bsd", "BSD-2-Clause", licensedb.ErrAliasExists},
+		{"mit", "MIT", licensedb.ErrAliasExists},
+		{"gpl3", "GPL-3.0-only", licensedb.ErrAliasExists},
+		{"gpl", "GPL-3.0-only", licensedb.ErrAliasExists},
+		{"foo", "NOT-A-LICENSE", licensedb.ErrUnknownID},
+		{"asl 2", "Apache-2.0", licensedb.ErrAliasExists},
+		{"", "Apache-2.0", licensedb.ErrInvalidAlias},
+		{" \t", "Apache-2.0", licensedb.ErrInvalidAlias},
+	}
+
+	// Cases depend on each other so they are not parallel
+	for _, tc := range tests {
+		got := db.RegisterAlias(tc.from, tc.to)
+		if !errors.Is(got, tc.want) {
+			t.Fatalf("RegisterAlias(%v, %v) = %v; want %v", tc.from, tc.to, got, tc.want)
+		}
+	}
+
+	normalised := []struct {
+		in   string
+		want string
+	}{
+		{"ASL-2 or Bsd-New", "Apache-2.0 OR BSD-3-Clause"},
+		{"GPLv3", "GPL-3.0"},
+		{"corp bsd OR MIT", "BSD-3-Clause OR MIT"},
+	}
+	for _, tc := range normalised {
+		got := db.Normalise(tc.in)
+		if got != tc.want {
+			t.Fatalf("Normalise(%v) = %v; want %v", tc.in, got, tc.want)
+		}
+	}
+	if got := licensedb.Normalise("corp bsd"); got == "BSD-3-Clause" {
+		t.Fatalf("Normalise(corp bsd) = %v in default database; want alias registered in other one only", got)
+	}
+
+	// Wrapper is checked with invalid aliases only, which leave default
+	// database as is
+	if err := licensedb.RegisterAlias(" ", "MIT"); !errors.Is(err, licensedb.ErrInvalidAlias) {
+		t.Fatalf("RegisterAlias( , MIT) = %v; want %v", err, licensedb.ErrInvalidAlias)
+	}
+}
+
+func Test_NormaliseWithProfile(t *testing.T) {
+	tests := []struct {
+		profile licensedb.Profile
+		in      string
+		want    string
+	}{
+		{licensedb.ProfileDefault, "GPL-2 gpl2", "GPL-2.0 GPL-2.0"},
+		{licensedb.ProfileSPDXStrict, "gpl3 asl20 mIt", "gpl3 asl20 MIT"},
+		{licensedb.ProfileSPDXStrict, "GPL-2.0+ gpl-3.0-only", "GPL-2.0+ GPL-3.0-only"},
+		{licensedb.ProfileGentoo, "GPL-2 GPL-2+", "GPL-2.0-only GPL-2.0-or-later"},
+		{licensedb.ProfileGentoo, "LGPL-2.1+ || BSD-2", "LGPL-2.1-or-later || BSD-2-Clause"},
+		{licensedb.ProfileNixpkgs, "gpl2 gpl3Plus asl20", "GPL-2.0-only GPL-3.0-or-later Apache-2.0"},
+		{licensedb.ProfileDebian, "Expat or GPL-2+", "MIT OR GPL-2.0-or-later"},
+		{licensedb.ProfileNPM, "GPL-2 and LGPL-3.0", "GPL-2.0-or-later AND LGPL-3.0-or-later"},
+		{licensedb.ProfilePyPI, "GPLv2 GPLv3+", "GPL-2.0-only GPL-3.0-or-later"},
+		{"unknown-profile", "GPL-2", "GPL-2.0"},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.profile)+" "+tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			got := licensedb.Normalise(tc.in, licensedb.WithProfile(tc.profile))
+			if got != tc.want {
+				t.Fatalf("Normalise(%v, %v) = %v; want %v", tc.in, tc.profile, got, tc.want)
+			}
+		})
+	}
+}
+
+func Test_NormaliseWithLossyAliases(t *testing.T) {
+	tests := []struct {
+		opts []licensedb.Option
+		in   string
+		want string
+	}{
+		{nil, "ASL 2.0 or Simplified BSD", "Apache-2.0 OR BSD-2-Clause"},
+		{nil, "BSD and PSF", "BSD AND PSF"},
+		{[]licensedb.Option{licensedb.WithLossyAliases()}, "BSD and PSF", "BSD-3-Clause AND PSF-2.0"},
+		{
+			[]licensedb.Option{licensedb.WithLossyAliases(), licensedb.WithProfile(licensedb.ProfileGentoo)},
+			"BSD-2 or GPL-2+ or Apache",
+			"BSD-2-Clause OR GPL-2.0-or-later OR Apache-2.0",
+		},
+		{
+			[]licensedb.Option{licensedb.WithLossyAliases(), licensedb.WithProfile(licensedb.ProfileSPDXStrict)},
+			"BSD or New BSD",
+			"bsd OR new bsd",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			got := licensedb.Normalise(tc.in, tc.opts...)
+			if got != tc.want {
+				t.Fatalf("Normalise(%v) = %v; want %v", tc.in, got, tc.want)
+			}
+		})
+	}
+
+	for _, alias := range licensedb.Aliases() {
+		if alias.From == "expat" && (alias.To != "MIT" || alias.Lossy) {
+			t.Fatalf("Aliases() has %v; want exact expat -> MIT", alias)
+		}
+	}
+}
+
+func Test_Complete(t *testing.T) {
+	tests := []struct {
+		prefix string
+		limit  int
+		want   []string
+	}{
+		{"apa", 1, []string{"Apache-2.0"}},
+		{"asl", 0, []string{"Apache-2.0", "Apache-1.1"}},
+		{"gpl3", 2, []string{"GPL-3.0-or-later", "GPL-3.0-only"}},
+		{"GPL-3.0-o", 0, []string{"GPL-3.0-or-later", "GPL-3.0-only"}},
+		{"mit", 2, []string{"MIT", "MIT-0"}},
+		{"llvm", 0, []string{"LLVM-exception"}},
+		{"", 2, []string{"MIT", "Apache-2.0"}},
+		{"qwertyuiop", 5, []string{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.prefix, func(t *testing.T) {
+			t.Parallel()
+
+			got := licensedb.Complete(tc.prefix, tc.limit)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("Complete(%v, %v) = %v; want %v", tc.prefix, tc.limit, got, tc.want)
+			}
+		})
+	}
+}
+
+func Test_Lookup(t *testing.T) {
+	tests := []struct {
+		in          string
+		id          string
+		isException bool
+		deprecated  bool
+		textPrefix  string
+	}{
+		{"MIT", "MIT", false, false, "MIT License"},
+		{"gpl3+", "GPL-3.0-or-later", false, false, "GNU GENERAL PUBLIC LICENSE"},
+		{"GPL-2.0", "GPL-2.0", false, true, "GNU GENERAL PUBLIC LICENSE"},
+		{"Autoconf-exception-3.0", "Autoconf-exception-3.0", true, false, "AUTOCONF CONFIGURE SCRIPT EXCEPTION"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			l, ok := licensedb.Lookup(tc.in)
+			if !ok {
+				t.Fatalf("Lookup(%v) not found", tc.in)
+			}
+			if l.ID != tc.id || l.IsException != tc.isException || l.Deprecated != tc.deprecated {
+				t.Fatalf(
+					"Lookup(%v) = %v %v %v; want %v %v %v",
+					tc.in,
+					l.ID, l.IsException, l.Deprecated,
+					tc.id, tc.isException, tc.deprecated,
+				)
+			}
+			if text := l.Text(); licensedb.Default().HasTexts() && !strings.HasPrefix(text, tc.textPrefix) {
+				t.Fatalf("Lookup(%v).Text() = %.40q...; want prefix %q", tc.in, text, tc.textPrefix)
+			}
+		})
+	}
+
+	if l, ok := licensedb.Lookup("fdsfsadf"); ok {
+		t.Fatalf("Lookup(fdsfsadf) = %v; want not found", l)
+	}
+}
+
+func Test_DefaultMetadata(t *testing.T) {
+	if !licensedb.Default().HasMetadata() {
+		t.Skip("embedded license list has no metadata, regenerate it from release with json/")
+	}
+	l, ok := licensedb.Lookup("MIT")
+	if !ok || l.Name != "MIT License" || !l.OSIApproved {
+		t.Fatalf("Lookup(MIT) = %+v, %v; want MIT License approved by OSI", l, ok)
+	}
+	for _, e := range licensedb.Exceptions() {
+		if e.Name == "" {
+			t.Fatalf("Exceptions() contains %v without name", e.ID)
+		}
+	}
+}
+
+func Test_Exceptions(t *testing.T) {
+	exceptions := licensedb.Exceptions()
+	ids := make([]string, len(exceptions))
+	for i, e := range exceptions {
+		if !e.IsException {
+			t.Fatalf("Exceptions() contains license %v", e.ID)
+		}
+		ids[i] = e.ID
+	}
+	if !slices.IsSorted(ids) {
+		t.Fatalf("Exceptions() is not sorted: %v", ids)
+	}
+	for _, id := range []string{"Classpath-exception-2.0", "LLVM-exception", "389-exception", "Linux-syscall-note"} {
+		if !slices.Contains(ids, id) {
+			t.Fatalf("Exceptions() does not contain %v", id)
+		}
+	}
+	for _, id := range []string{"MIT", "CAL-1.0-Combined-Work-Exception", "MPL-2.0-no-copyleft-exception"} {
+		if slices.Contains(ids, id) {
+			t.Fatalf("Exceptions() contains license %v", id)
+		}
+	}
+
+	gcc, _ := licensedb.Lookup("GCC-exception-3.1")
+	if !slices.Contains(gcc.RelatedLicenses, "GPL-3.0-or-later") {
+		t.Fatalf("GCC-exception-3.1 related licenses = %v; want GPL-3.0-or-later", gcc.RelatedLicenses)
+	}
+
+	_, e, _, _, _ := licensedb.Extract("GPL-2.0-only WITH Classpath-exception-2.0 OR Apache-2.0 WITH LLVM-exception")
+	if !reflect.DeepEqual(e, []string{"Classpath-exception-2.0", "LLVM-exception"}) {
+		t.Fatalf("Extract() exceptions = %v; want Classpath-exception-2.0 LLVM-exception", e)
+	}
+}
+
+func Test_Query(t *testing.T) {
+	ids := func(licenses []licensedb.License) []string {
+		out := make([]string, len(licenses))
+		for i, l := range licenses {
+			out[i] = l.ID
+		}
+		return out
+	}
+
+	all := ids(licensedb.Query())
+	if !slices.IsSorted(all) || !reflect.DeepEqual(all, licensedb.List()) {
+		t.Fatalf("Query() is not sorted or differs from List()")
+	}
+
+	exceptions := ids(licensedb.Query(licensedb.IsException))
+	if !reflect.DeepEqual(exceptions, ids(licensedb.Exceptions())) {
+		t.Fatalf("Query(IsException) = %v; want Exceptions()", exceptions)
+	}
+
+	bsd := ids(licensedb.Query(licensedb.InFamily("bsd"), licensedb.Not(licensedb.IsDeprecated)))
+	for _, id := range []string{"0BSD", "BSD-2-Clause", "BSD-3-Clause"} {
+		if !slices.Contains(bsd, id) {
+			t.Fatalf("Query(InFamily(bsd)) does not contain %v", id)
+		}
+	}
+	for _, id := range []string{"MIT", "BSD-2-Clause-FreeBSD"} {
+		if slices.Contains(bsd, id) {
+			t.Fatalf("Query(InFamily(bsd), Not(IsDeprecated)) contains %v", id)
+		}
+	}
+
+	gpl := ids(licensedb.Query(
+		licensedb.AnyOf(licensedb.InFamily("GPL"), licensedb.InFamily("LGPL")),
+		licensedb.Not(licensedb.IsException),
+		licensedb.Not(licensedb.IsDeprecated),
+	))
+	for _, id := range []string{"GPL-3.0-only", "LGPL-2.1-or-later"} {
+		if !slices.Contains(gpl, id) {
+			t.Fatalf("Query(GPL or LGPL) does not contain %v", id)
+		}
+	}
+	for _, id := range []string{"AGPL-3.0-only", "GPL-3.0-linking-exception", "GPL-2.0"} {
+		if slices.Contains(gpl, id) {
+			t.Fatalf("Query(GPL or LGPL) contains %v", id)
+		}
+	}
+}
+
+// metaFixture returns database of release layout with metadata
+func metaFixture(t *testing.T) *licensedb.DB {
+	t.Helper()
+	db, err := licensedb.Open(fstest.MapFS{
+		"text/MIT.txt":                {Data: []byte("MIT License")},
+		"text/BSD-3-Clause.txt":       {Data: []byte("BSD 3-Clause")},
+		"text/MPL-2.0.txt":            {Data: []byte("Mozilla Public License 2.0")},
+		"text/deprecated_GPL-2.0.txt": {Data: []byte("GPL 2")},
+		"json/licenses.json": {Data: []byte(`{"licenseListVersion": "9.99", "licenses": [
+			{"licenseId": "MIT", "name": "MIT License", "isOsiApproved": true, "isFsfLibre": true,
+			 "seeAlso": ["https://opensource.org/license/mit/"]},
+			{"licenseId": "BSD-3-Clause", "name": "BSD 3-Clause \"New\" or \"Revised\" License",
+			 "isOsiApproved": true, "seeAlso": ["https://opensource.org/licenses/BSD-3-Clause"]},
+			{"licenseId": "MPL-2.0", "name": "Mozilla Public License 2.0", "isOsiApproved": true,
+			 "seeAlso": ["https://www.mozilla.org/MPL/2.0/", "https://opensource.org/licenses/MPL-2.0"]},
+			{"licenseId": "GPL-2.0", "name": "GNU General Public License v2.0 only",
+			 "isOsiApproved": true, "isDeprecatedLicenseId": true}
+		]}`)},
+	})
+	if err != nil {
+		t.Fatalf("Open() error: %v", err)
+	}
+	return db
+}
+
+func Test_QueryMetadata(t *testing.T) {
+	ids := func(licenses []licensedb.License) []string {
+		out := make([]string, len(licenses))
+		for i, l := range licenses {
+			out[i] = l.ID
+		}
+		return out
+	}
+
+	db := metaFixture(t)
+	got := ids(db.Query(licensedb.IsOSIApproved, licensedb.Not(licensedb.IsDeprecated)))
+	if want := []string{"BSD-3-Clause", "MIT", "MPL-2.0"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Query(IsOSIApproved, Not(IsDeprecated)) = %v; want %v", got, want)
+	}
+	if got, want := ids(db.Query(licensedb.IsFSFLibre)), []string{"MIT"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Query(IsFSFLibre) = %v; want %v", got, want)
+	}
+
+	if !licensedb.Default().HasMetadata() {
+		t.Skip("embedded license list has no metadata, regenerate it from release with json/")
+	}
+	osi := ids(licensedb.Query(licensedb.IsOSIApproved, licensedb.Not(licensedb.IsDeprecated)))
+	for _, id := range []string{"MIT", "Apache-2.0", "GPL-3.0-only", "MPL-2.0"} {
+		if !slices.Contains(osi, id) {
+			t.Fatalf("Query(IsOSIApproved, Not(IsDeprecated)) does not contain %v", id)
+		}
+	}
+	for _, id := range []string{"GPL-2.0", "CC-BY-4.0"} {
+		if slices.Contains(osi, id) {
+			t.Fatalf("Query(IsOSIApproved, Not(IsDeprecated)) contains %v", id)
+		}
+	}
+	if libre := ids(licensedb.Query(licensedb.IsFSFLibre)); !slices.Contains(libre, "GPL-3.0-or-later") {
+		t.Fatalf("Query(IsFSFLibre) does not contain GPL-3.0-or-later")
+	}
+}
+
+func Test_Filters(t *testing.T) {
+	l := licensedb.License{ID: "MIT", Family: "MIT", OSIApproved: true, FSFLibre: true}
+	tests := []struct {
+		name   string
+		filter licensedb.Filter
+		want   bool
+	}{
+		{"IsOSIApproved", licensedb.IsOSIApproved, true},
+		{"IsFSFLibre", licensedb.IsFSFLibre, true},
+		{"IsDeprecated", licensedb.IsDeprecated, false},
+		{"IsException", licensedb.IsException, false},
+		{"Not(IsDeprecated)", licensedb.Not(licensedb.IsDeprecated), true},
+		{"InFamily(mit)", licensedb.InFamily("mit"), true},
+		{"AnyOf()", licensedb.AnyOf(), false},
+		{"AnyOf(IsException, IsOSIApproved)", licensedb.AnyOf(licensedb.IsException, licensedb.IsOSIApproved), true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.filter(l)
+			if got != tc.want {
+				t.Fatalf("%v(%v) = %v; want %v", tc.name, l.ID, got, tc.want)
+			}
+		})
+	}
+}
+
+func Test_FromName(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"Apache License, Version 2.0", "Apache-2.0"},
+		{"The Apache Software License, Version 2.0", "Apache-2.0"},
+		{"GNU Lesser General Public License v2.1 or later", "LGPL-2.1-or-later"},
+		{"The MIT License", "MIT"},
+		{"Simplified BSD License", "BSD-2-Clause"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			got := licensedb.FromName(tc.in)
+			if len(got) == 0 || got[0].ID != tc.want {
+				t.Fatalf("FromName(%v) = %v; want %v first", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func Test_FromNameMetadata(t *testing.T) {
+	// Official names that are not among curated common names
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`BSD 3-Clause "New" or "Revised" License`, "BSD-3-Clause"},
+		{"Creative Commons Attribution 4.0 International", "CC-BY-4.0"},
+		{"GNU Affero General Public License v3.0 or later", "AGPL-3.0-or-later"},
+		{"Boost Software License 1.0", "BSL-1.0"},
+	}
+
+	bsd := `BSD 3-Clause "New" or "Revised" License`
+	if got := metaFixture(t).FromName(bsd); len(got) == 0 || got[0].ID != "BSD-3-Clause" {
+		t.Fatalf("FromName(%v) = %v; want BSD-3-Clause first", bsd, got)
+	}
+
+	if !licensedb.Default().HasMetadata() {
+		t.Skip("embedded license list has no metadata, regenerate it from release with json/")
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			got := licensedb.FromName(tc.in)
+			if len(got) == 0 || got[0].ID != tc.want {
+				t.Fatalf("FromName(%v) = %v; want %v first", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func Test_FromURL(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"http://www.apache.org/licenses/LICENSE-2.0.txt", "Apache-2.0"},
+		{"https://opensource.org/licenses/MIT", "MIT"},
+		{"https://spdx.org/licenses/GPL-3.0-or-later.html", "GPL-3.0-or-later"},
+		{"https://www.mozilla.org/MPL/2.0/", "MPL-2.0"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			got := licensedb.FromURL(tc.in)
+			if len(got) == 0 || got[0].ID != tc.want {
+				t.Fatalf("FromURL(%v) = %v; want %v first", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func Test_FromURLSeeAlso(t *testing.T) {
+	db := metaFixture(t)
+	for url, want := range map[string]string{
+		"https://opensource.org/license/mit/":          "MIT",
+		"https://opensource.org/licenses/BSD-3-Clause": "BSD-3-Clause",
+		"https://opensource.org/licenses/MPL-2.0":      "MPL-2.0",
+	} {
+		if got := db.FromURL(url); len(got) == 0 || got[0].ID != want {
+			t.Fatalf("FromURL(%v) = %v; want %v first", url, got, want)
+		}
+	}
+
+	if !licensedb.Default().HasMetadata() {
+		t.Skip("embedded license list has no metadata, regenerate it from release with json/")
+	}
+	// Every cross reference of license finds it
+	for _, l := range licensedb.Query(licensedb.Not(licensedb.IsDeprecated)) {
+		for _, url := range l.SeeAlso {
+			found := false
+			for _, c := range licensedb.FromURL(url) {
+				found = found || c.ID == l.ID
+			}
+			if !found {
+				t.Errorf("FromURL(%v) does not contain %v", url, l.ID)
+			}
+		}
+	}
+}
+
+func Test_DBConcurrentUse(t *testing.T) {
+	db := privateDB(t)
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			alias := fmt.Sprintf("concurrent-%d", i)
+			if err := db.RegisterAlias(alias, "MIT"); err != nil {
+				t.Errorf("RegisterAlias(%v, MIT) = %v; want <nil>", alias, err)
+			}
+			if got := db.Normalise(alias + " or asl20"); got != "MIT OR Apache-2.0" {
+				t.Errorf("Normalise(%v or asl20) = %v; want MIT OR Apache-2.0", alias, got)
+			}
+			db.Complete("concurrent", 0)
+			db.Suggest("concurent-1", 1)
+		}()
+	}
+	wg.Wait()
+}
+
+func Test_OpenZip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "license-list-data.zip")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw := zip.NewWriter(f)
+	for name, data := range map[string]string{
+		"license-list-data-9.99/text/MIT.txt":                "MIT License",
+		"license-list-data-9.99/text/Patched-1.0.txt":        "Patched License",
+		"license-list-data-9.99/text/deprecated_GPL-2.0.txt": "GPL 2",
+		"license-list-data-9.99/json/licenses.json": `{"licenseListVersion": "9.99", "licenses": [
+			{"licenseId": "Patched-1.0", "name": "Patched License 1.0", "isOsiApproved": true}
+		]}`,
+	} {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(data))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	db, err := licensedb.OpenZip(path)
+	if err != nil {
+		t.Fatalf("OpenZip() error: %v", err)
+	}
+	if got, want := db.List(), []string{"GPL-2.0", "MIT", "Patched-1.0"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("List() = %v; want %v", got, want)
+	}
+	l, ok := db.Lookup("patched-1")
+	if !ok || l.Name != "Patched License 1.0" || !l.OSIApproved || l.Text() != "Patched License" {
+		t.Fatalf("Lookup(patched-1) = %+v, %v; want Patched-1.0", l, ok)
+	}
+	if !db.HasMetadata() {
+		t.Fatalf("HasMetadata() = false; want true")
+	}
+	if l.ListVersion != "9.99" || db.ListVersion() != "9.99" {
+		t.Fatalf("ListVersion() = %v, %v; want 9.99", db.ListVersion(), l.ListVersion)
+	}
+	if got := db.Normalise("mit OR gpl-2.0"); got != "MIT OR GPL-2.0" {
+		t.Fatalf("Normalise() = %v; want MIT OR GPL-2.0", got)
+	}
+	if _, ok := licensedb.Lookup("Patched-1.0"); ok {
+		t.Fatalf("Lookup(Patched-1.0) in default database succeeded")
+	}
+
+	if _, err := licensedb.OpenZip(filepath.Join(t.TempDir(), "missing.zip")); err == nil {
+		t.Fatalf("OpenZip(missing.zip) succeeded; want error")
+	}
+	if _, err := licensedb.Open(fstest.MapFS{}); !errors.Is(err, licensedb.ErrNoLicenses) {
+		t.Fatalf("Open(empty) error = %v; want %v", err, licensedb.ErrNoLicenses)
+	}
+}
+
+func Test_Version(t *testing.T) {
+	t.Parallel()
+	if !slices.Contains(licensedb.Versions(), licensedb.Default().ListVersion()) {
+		t.Fatalf("Versions() = %v; want to contain %v", licensedb.Versions(), licensedb.Default().ListVersion())
+	}
+	cases := []struct {
+		version string
+		want    string
+		err     error
+	}{
+		{"3.27", "3.27.0", nil},
+		{"3.27.0", "3.27.0", nil},
+		{"v3.27.0", "3.27.0", nil},
+		{"3", "3.27.0", nil},
+		{"3.2", "", licensedb.ErrUnknownVersion},
+		{"1.0", "", licensedb.ErrUnknownVersion},
+		{"", "", licensedb.ErrUnknownVersion},
+	}
+	for _, c := range cases {
+		t.Run(c.version, func(t *testing.T) {
+			t.Parallel()
+			db, err := licensedb.Version(c.version)
+			if !errors.Is(err, c.err) {
+				t.Fatalf("Version(%v) error = %v; want %v", c.version, err, c.err)
+			}
+			if err != nil {
+				return
+			}
+			if got := db.ListVersion(); got != c.want {
+				t.Fatalf("Version(%v).ListVersion() = %v; want %v", c.version, got, c.want)
+			}
+			if l, ok := db.Lookup("MIT"); !ok || l.ListVersion != c.want {
+				t.Fatalf("Version(%v).Lookup(MIT) = %+v, %v; want ListVersion %v", c.version, l, ok, c.want)
+			}
+		})
+	}
+
+	// Databases of versions don't share state with Default
+	v, err := licensedb.Version(licensedb.Default().ListVersion())
+	if err != nil {
+		t.Fatalf("Version(%v) error: %v", licensedb.Default().ListVersion(), err)
+	}
+	if v == licensedb.Default() {
+		t.Fatalf("Version(%v) = Default(); want new database", v.ListVersion())
+	}
+	if err := v.RegisterAlias("version-only license", "MIT"); err != nil {
+		t.Fatalf("RegisterAlias(version-only license, MIT) error: %v", err)
+	}
+	if got := licensedb.Normalise("version-only license"); got == "MIT" {
+		t.Fatalf("Normalise(version-only license) = MIT; want alias of Version() not in Default()")
+	}
+}
+
+func Test_DiffVersions(t *testing.T) {
+	t.Parallel()
+	open := func(version, licenses string, files ...string) *licensedb.DB {
+		fsys := fstest.MapFS{
+			"json/licenses.json": {Data: []byte(`{"licenseListVersion": "` + version + `", "licenses": [` + licenses + `]}`)},
+			"json/exceptions.json": {Data: []byte(`{"exceptions": [
+				{"licenseExceptionId": "Foo-exception", "name": "Foo"}
+			]}`)},
+		}
+		for _, f := range files {
+			fsys["text/"+f+".txt"] = &fstest.MapFile{Data: []byte(f)}
+		}
+		db, err := licensedb.Open(fsys)
+		if err != nil {
+			t.Fatalf("Open(%v) error: %v", version, err)
+		}
+		return db
+	}
+	a := open("1.0", `
+		{"licenseId": "MIT", "name": "MIT License", "isOsiApproved": false},
+		{"licenseId": "GPL-2.0", "name": "GPL 2"},
+		{"licenseId": "Old-1.0", "name": "Old"}`,
+		"MIT", "GPL-2.0", "Old-1.0")
+	b := open("1.1", `
+		{"licenseId": "MIT", "name": "MIT License", "isOsiApproved": true},
+		{"licenseId": "GPL-2.0", "name": "GPL 2", "isDeprecatedLicenseId": true},
+		{"licenseId": "New-1.0", "name": "New"}`,
+		"MIT", "deprecated_GPL-2.0", "New-1.0", "Foo-exception")
+
+	got := licensedb.DiffVersions(a, b)
+	want := licensedb.Diff{
+		From:            "1.0",
+		To:              "1.1",
+		Added:           []string{"New-1.0"},
+		Removed:         []string{"Old-1.0"},
+		Deprecated:      []string{"GPL-2.0"},
+		ExceptionsAdded: []string{"Foo-exception"},
+		Changed:         []licensedb.Change{{"MIT", "OSIApproved", "false", "true"}},
+
+		MetadataCompared: true,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("DiffVersions() = %#v; want %#v", got, want)
+	}
+	if s := got.String(); !strings.Contains(s, "1.0 -> 1.1") || !strings.Contains(s, "  New-1.0\n") {
+		t.Fatalf("Diff.String() = %q; want changelog of 1.0 -> 1.1", s)
+	}
+	if d := licensedb.DiffVersions(a, a); !d.Empty() {
+		t.Fatalf("DiffVersions(a, a) = %+v; want empty", d)
+	}
+
+	noMeta, err := licensedb.Open(fstest.MapFS{"text/MIT.txt": {Data: []byte("MIT")}})
+	if err != nil {
+		t.Fatalf("Open() error: %v", err)
+	}
+	if noMeta.HasMetadata() {
+		t.Fatalf("HasMetadata() = true for list without json/; want false")
+	}
+	d := licensedb.DiffVersions(noMeta, b)
+	if d.MetadataCompared || !strings.Contains(d.String(), "not compared") {
+		t.Fatalf("DiffVersions(no metadata, b) = %#v; want MetadataCompared = false and note", d)
+	}
+}
+
+func Test_DiffText(t *testing.T) {
+	t.Parallel()
+	open := func(version, text string) *licensedb.DB {
+		db, err := licensedb.Open(fstest.MapFS{
+			"text/OFL-1.1.txt":   {Data: []byte(text)},
+			"json/licenses.json": {Data: []byte(`{"licenseListVersion": "` + version + `", "licenses": []}`)},
+		})
+		if err != nil {
+			t.Fatalf("Open(%v) error: %v", version, err)
+		}
+		return db
+	}
+	a := open("1.0", "Font License\n\nCopyright holder\n")
+	b := open("1.1", "Font License\n\nCopyright Holder\n")
+
+	got, err := licensedb.DiffText(a, b, "ofl-1.1")
+	want := "--- OFL-1.1 1.0\n+++ OFL-1.1 1.1\n@@ -1,3 +1,3 @@\n Font License\n \n-Copyright holder\n+Copyright Holder\n"
+	if err != nil || got != want {
+		t.Fatalf("DiffText(OFL-1.1) = %q, %v; want %q", got, err, want)
+	}
+	got, err = licensedb.DiffTextWords(a, b, "OFL-1.1")
+	want = "--- OFL-1.1 1.0\n+++ OFL-1.1 1.1\n@@ -1,3 +1,3 @@\nFont License\n\nCopyright [-holder-] {+Holder+}\n"
+	if err != nil || got != want {
+		t.Fatalf("DiffTextWords(OFL-1.1) = %q, %v; want %q", got, err, want)
+	}
+	if got, err := licensedb.DiffText(a, a, "OFL-1.1"); err != nil || got != "" {
+		t.Fatalf("DiffText(a, a) = %q, %v; want empty", got, err)
+	}
+	if _, err := licensedb.DiffText(a, b, "MIT"); !errors.Is(err, licensedb.ErrUnknownID) {
+		t.Fatalf("DiffText(MIT) error = %v; want %v", err, licensedb.ErrUnknownID)
+	}
+}
+
+// FS whose license texts can be listed but not read
+type unreadableFS struct {
+	files fstest.MapFS
+}
+
+func (f unreadableFS) Open(name string) (fs.File, error) {
+	if strings.HasPrefix(name, "text/") {
+		return nil, fs.ErrPermission
+	}
+	return f.files.Open(name)
+}
+
+func Test_Text(t *testing.T) {
+	t.Parallel()
+	if !licensedb.Default().HasTexts() {
+		t.Skip("built without license texts")
+	}
+	text, err := licensedb.Text("gpl3+")
+	if err != nil || !strings.Contains(text, "GNU GENERAL PUBLIC LICENSE") {
+		t.Fatalf("Text(gpl3+) = %.40q..., %v; want GPL-3.0-or-later text", text, err)
+	}
+	r, err := licensedb.OpenText("MIT")
+	if err != nil {
+		t.Fatalf("OpenText(MIT) error: %v", err)
+	}
+	data, err := io.ReadAll(r)
+	r.Close()
+	if want, _ := licensedb.Text("MIT"); err != nil || string(data) != want {
+		t.Fatalf("OpenText(MIT) = %.40q..., %v; want %.40q...", data, err, want)
+	}
+	if _, err := licensedb.Text("no-such-license"); !errors.Is(err, licensedb.ErrUnknownID) {
+		t.Fatalf("Text(no-such-license) error = %v; want %v", err, licensedb.ErrUnknownID)
+	}
+}
+
+func Test_TextError(t *testing.T) {
+	t.Parallel()
+	db, err := licensedb.Open(unreadableFS{fstest.MapFS{"text/MIT.txt": {Data: []byte("MIT License")}}})
+	if err != nil {
+		t.Fatalf("Open() error: %v", err)
+	}
+	if _, err := db.Text("MIT"); !errors.Is(err, fs.ErrPermission) {
+		t.Fatalf("Text(MIT) error = %v; want %v", err, fs.ErrPermission)
+	}
+	if _, _, _, err := db.GetFiles("MIT OR Foo"); !errors.Is(err, fs.ErrPermission) {
+		t.Fatalf("GetFiles(MIT OR Foo) error = %v; want %v", err, fs.ErrPermission)
+	}
+}
+
+func Test_FS(t *testing.T) {
+	t.Parallel()
+	if !licensedb.Default().HasTexts() {
+		t.Skip("built without license texts")
+	}
+	fsys := licensedb.FS()
+	for _, id := range []string{"MIT", "GPL-3.0-or-later", "GPL-2.0"} {
+		data, err := fs.ReadFile(fsys, "licenses/"+id+".txt")
+		if want, _ := licensedb.Text(id); err != nil || string(data) != want {
+			t.Fatalf("ReadFile(licenses/%v.txt) = %.40q..., %v; want %.40q...", id, data, err, want)
+		}
+	}
+	if _, err := fs.Stat(fsys, "exceptions/Classpath-exception-2.0.txt"); err != nil {
+		t.Fatalf("Stat(exceptions/Classpath-exception-2.0.txt) error: %v", err)
+	}
+	count := 0
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			count++
+		}
+		return err
+	})
+	if err != nil || count != len(licensedb.List()) {
+		t.Fatalf("WalkDir() found %v files, %v; want %v", count, err, len(licensedb.List()))
+	}
+
+	// Range requests need files implementing io.Seeker
+	srv := httptest.NewServer(http.FileServerFS(fsys))
+	defer srv.Close()
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/licenses/MIT.txt", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Range", "bytes=0-10")
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if want, _ := licensedb.Text("MIT"); resp.StatusCode != http.StatusPartialContent || string(body) != want[:11] {
+		t.Fatalf("GET licenses/MIT.txt with Range = %v %q; want 206 %q", resp.Status, body, want[:11])
+	}
+}
+
+func Test_SameText(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"GPL-3.0-only", "GPL-3.0-or-later", true},
+		{"gpl3", "gpl3+", true},
+		{"GPL-2.0", "GPL-2.0-only", true},
+		{"GPL-3.0-only", "MIT", false},
+		{"GPL-3.0-only", "no-such-license", false},
+	}
+	for _, c := range cases {
+		t.Run(c.a+"_"+c.b, func(t *testing.T) {
+			t.Parallel()
+			if got := licensedb.SameText(c.a, c.b); got != c.want {
+				t.Fatalf("SameText(%v, %v) = %v; want %v", c.a, c.b, got, c.want)
+			}
+		})
+	}
+}
+
+func Test_GetFiles(t *testing.T) {
+	t.Parallel()
+	if !licensedb.Default().HasTexts() {
+		t.Skip("built without license texts")
+	}
+	licenses, exceptions, unknown, err := licensedb.GetFiles("GPL-3.0-only OR GPL-3.0-or-later WITH Classpath-exception-2.0 OR MIT OR Foo")
+	if err != nil {
+		t.Fatalf("GetFiles() error: %v", err)
+	}
+	if got := slices.Sorted(maps.Keys(licenses)); !reflect.DeepEqual(got, []string{"GPL-3.0-only", "MIT"}) {
+		t.Fatalf("GetFiles() licenses = %v; want [GPL-3.0-only MIT]", got)
+	}
+	if _, ok := exceptions["Classpath-exception-2.0"]; !ok || len(exceptions) != 1 {
+		t.Fatalf("GetFiles() exceptions = %v; want [Classpath-exception-2.0]", slices.Collect(maps.Keys(exceptions)))
+	}
+	if !reflect.DeepEqual(unknown, []string{"foo"}) {
+		t.Fatalf("GetFiles() unknown = %v; want [foo]", unknown)
+	}
+}
+
+func Test_Manifest(t *testing.T) {
+	t.Parallel()
+	m, ok := licensedb.Default().Manifest()
+	if !ok {
+		t.Fatalf("Default().Manifest() = _, false; want manifest")
+	}
+	if m.ListVersion != licensedb.Default().ListVersion() {
+		t.Fatalf("Manifest().ListVersion = %v; want %v", m.ListVersion, licensedb.Default().ListVersion())
+	}
+	if m.Files != len(m.Hashes) || m.Files < m.Texts || m.Texts == 0 {
+		t.Fatalf("Manifest() = %d files, %d texts, %d hashes; want consistent counts", m.Files, m.Texts, len(m.Hashes))
+	}
+	if licensedb.Default().HasTexts() {
+		text, _ := licensedb.Text("MIT")
+		if sum := sha256.Sum256([]byte(text)); m.Hashes["MIT"] != hex.EncodeToString(sum[:]) {
+			t.Fatalf("Manifest().Hashes[MIT] = %v; want SHA-256 of MIT text", m.Hashes["MIT"])
+		}
+	}
+	if m.Hashes["GPL-3.0-only"] != m.Hashes["GPL-3.0-or-later"] {
+		t.Fatalf("Manifest().Hashes differ for GPL-3.0-only and GPL-3.0-or-later")
+	}
+
+	db, err := licensedb.Open(fstest.MapFS{"text/MIT.txt": {Data: []byte("MIT License")}})
+	if err != nil {
+		t.Fatalf("Open() error: %v", err)
+	}
+	if _, ok := db.Manifest(); ok {
+		t.Fatalf("Manifest() of checkout = _, true; want false")
+	}
+}
+
+func Test_ID(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		id         licensedb.ID
+		want       string
+		exception  bool
+		deprecated bool
+	}{
+		{licensedb.MIT, "MIT", false, false},
+		{licensedb.Apache_2_0, "Apache-2.0", false, false},
+		{licensedb.GPL_3_0_or_later, "GPL-3.0-or-later", false, false},
+		{licensedb.GPL_2_0, "GPL-2.0", false, true},
+		{licensedb.Classpath_exception_2_0, "Classpath-exception-2.0", true, false},
+		{licensedb.ID_0BSD, "0BSD", false, false},
+		{licensedb.Curl, "curl", false, false},
+		{licensedb.ID("no-such-license"), "no-such-license", false, false},
+	}
+	for _, c := range cases {
+		t.Run(c.want, func(t *testing.T) {
+			t.Parallel()
+			if got := c.id.String(); got != c.want {
+				t.Fatalf("String() = %v; want %v", got, c.want)
+			}
+			if got := c.id.IsException(); got != c.exception {
+				t.Fatalf("%v.IsException() = %v; want %v", c.id, got, c.exception)
+			}
+			if got := c.id.IsDeprecated(); got != c.deprecated {
+				t.Fatalf("%v.IsDeprecated() = %v; want %v", c.id, got, c.deprecated)
+			}
+			l, ok := licensedb.Lookup(c.id)
+			if _, exists := c.id.License(); ok != exists || ok && l.ID != c.want {
+				t.Fatalf("Lookup(%v) = %v, %v; want %v", c.id, l.ID, ok, c.want)
+			}
+			if text, err := c.id.Text(); licensedb.Default().HasTexts() && ok && (err != nil || text == "") {
+				t.Fatalf("%v.Text() = %.40q..., %v; want text", c.id, text, err)
+			}
+		})
+	}
+
+	if !licensedb.GPL_3_0_only.SameText(licensedb.GPL_3_0_or_later) {
+		t.Fatalf("GPL_3_0_only.SameText(GPL_3_0_or_later) = false; want true")
+	}
+	if got, want := licensedb.GPL_3_0_or_later.ShortForms(), licensedb.ToShortForms("GPL-3.0-or-later"); !reflect.DeepEqual(got, want) {
+		t.Fatalf("GPL_3_0_or_later.ShortForms() = %v; want %v", got, want)
+	}
+
+	// Functions taking an ID accept both IDs and strings
+	if !licensedb.SameText(licensedb.GPL_3_0_only, "GPL-3.0-or-later") {
+		t.Fatalf("SameText(GPL_3_0_only, GPL-3.0-or-later) = false; want true")
+	}
+	if text, err := licensedb.Text(licensedb.MIT); licensedb.Default().HasTexts() && (err != nil || text == "") {
+		t.Fatalf("Text(MIT) = %.40q..., %v; want text", text, err)
+	}
+
+	// Functions taking free form text keep string signatures, so they
+	// can be used as values
+	var normalise func(string, ...licensedb.Option) string = licensedb.Normalise
+	if got := normalise(licensedb.GPL_3_0_or_later.String()); got != "GPL-3.0-or-later" {
+		t.Fatalf("Normalise(GPL_3_0_or_later) = %v; want GPL-3.0-or-later", got)
+	}
+}
+
+const mitTemplate = `<<beginOptional>>MIT License<<endOptional>>
+
+<<var;name="copyright";original="Copyright (c) <year> <copyright holders>";match=".{0,5000}">>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy of <<var;name="software";original="this software and associated documentation files (the "Software")";match="this software and associated documentation files \(the "?Software"?\)|this source file \(the "?Software"?\)">>, to deal in the Software without restriction.
+`
+
+func Test_Template(t *testing.T) {
+	t.Parallel()
+	db, err := licensedb.Open(fstest.MapFS{
+		"text/MIT.txt":                    {Data: []byte("MIT License")},
+		"text/0BSD.txt":                   {Data: []byte("BSD Zero Clause License")},
+		"template/MIT.template.txt":       {Data: []byte(mitTemplate)},
+		"template/Broken.template.txt":    {Data: []byte("<<beginOptional>>")},
+		"template/README.md":              {Data: []byte("templates")},
+		"text/Broken.txt":                 {Data: []byte("Broken")},
+		"template/Unrelated.template.txt": {Data: []byte("Unrelated")},
+	})
+	if err != nil {
+		t.Fatalf("Open() error: %v", err)
+	}
+	tmpl, err := db.Template("mit")
+	if err != nil {
+		t.Fatalf("Template(mit) error: %v", err)
+	}
+	if tmpl.ID != "MIT" {
+		t.Fatalf("Template(mit).ID = %v; want MIT", tmpl.ID)
+	}
+	if got, want := tmpl.Vars(), []string{"copyright", "software"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Vars() = %v; want %v", got, want)
+	}
+
+	filled := tmpl.Fill(map[string]string{"copyright": "Copyright (c) 2024 Jane Doe"})
+	want := "MIT License\n\nCopyright (c) 2024 Jane Doe\n\n" +
+		"Permission is hereby granted, free of charge, to any person obtaining a copy of " +
+		`this software and associated documentation files (the "Software"), ` +
+		"to deal in the Software without restriction.\n"
+	if filled != want {
+		t.Fatalf("Fill() = %q; want %q", filled, want)
+	}
+
+	re, err := tmpl.Regexp()
+	if err != nil {
+		t.Fatalf("Regexp() error: %v", err)
+	}
+	cases := []struct {
+		text string
+		want bool
+	}{
+		{filled, true},
+		{"Copyright 2020 Someone Else\n\nPermission is hereby granted, free of charge, to any person " +
+			"obtaining a copy of this source file (the Software),\nto deal in the Software without restriction.", true},
+		{"MIT License\n\nPermission is hereby granted to nobody.", false},
+	}
+	for _, c := range cases {
+		if got := re.MatchString(c.text); got != c.want {
+			t.Fatalf("Regexp().MatchString(%q) = %v; want %v", c.text, got, c.want)
+		}
+	}
+
+	if _, err := db.Template("0BSD"); !errors.Is(err, licensedb.ErrNoTemplate) {
+		t.Fatalf("Template(0BSD) error = %v; want %v", err, licensedb.ErrNoTemplate)
+	}
+	if _, err := db.Template("Broken"); !errors.Is(err, licensedb.ErrInvalidTemplate) {
+		t.Fatalf("Template(Broken) error = %v; want %v", err, licensedb.ErrInvalidTemplate)
+	}
+	if _, err := db.Template("Unrelated"); !errors.Is(err, licensedb.ErrUnknownID) {
+		t.Fatalf("Template(Unrelated) error = %v; want %v", err, licensedb.ErrUnknownID)
+	}
+}
+
+func Test_DefaultTemplate(t *testing.T) {
+	t.Parallel()
+	if m, _ := licensedb.Default().Manifest(); m.Templates == 0 {
+		t.Skip("embedded license list has no templates, regenerate it from release with template/")
+	}
+	if !licensedb.Default().HasTexts() {
+		if _, err := licensedb.MIT.Template(); !errors.Is(err, licensedb.ErrNoText) {
+			t.Fatalf("Template(MIT) error = %v; want %v", err, licensedb.ErrNoText)
+		}
+		return
+	}
+	for _, id := range []licensedb.ID{licensedb.MIT, licensedb.Apache_2_0, licensedb.BSD_3_Clause} {
+		tmpl, err := id.Template()
+		if err != nil {
+			t.Fatalf("Template(%v) error: %v", id, err)
+		}
+		if !slices.Contains(tmpl.Vars(), "copyright") {
+			t.Fatalf("Template(%v).Vars() = %v; want copyright", id, tmpl.Vars())
+		}
+		re, err := tmpl.Regexp()
+		if err != nil {
+			t.Fatalf("Template(%v).Regexp() error: %v", id, err)
+		}
+		text, _ := id.Text()
+		if !re.MatchString(text) {
+			t.Fatalf("Template(%v).Regexp() does not match its text", id)
+		}
+	}
+}
+
+func Test_Header(t *testing.T) {
+	t.Parallel()
+	db, err := licensedb.Open(fstest.MapFS{
+		"text/Apache-2.0.txt": {Data: []byte("Apache License")},
+		"text/MIT.txt":        {Data: []byte("MIT License")},
+		"json/licenses.json": {Data: []byte(`{"licenseListVersion": "1.0", "licenses": [
+			{"licenseId": "Apache-2.0", "name": "Apache License 2.0"},
+			{"licenseId": "MIT", "name": "MIT License"}
+		]}`)},
+		"json/details/Apache-2.0.json": {Data: []byte(`{"standardLicenseHeader":
+			"Copyright [yyyy] [name of copyright owner]\n\nLicensed under the Apache License, Version 2.0 (the \"License\");\nyou may not use this file except in compliance with the License.\n"}`)},
+	})
+	if err != nil {
+		t.Fatalf("Open() error: %v", err)
+	}
+	got, err := db.Header("apache2", licensedb.Vars{Year: "2024", Holder: "Jane Doe"})
+	want := "Copyright 2024 Jane Doe\n\nLicensed under the Apache License, Version 2.0 (the \"License\");\n" +
+		"you may not use this file except in compliance with the License."
+	if err != nil || got != want {
+		t.Fatalf("Header(apache2) = %q, %v; want %q", got, err, want)
+	}
+	if l, _ := db.Lookup("Apache-2.0"); !strings.HasPrefix(l.Header, "Copyright [yyyy]") {
+		t.Fatalf("Lookup(Apache-2.0).Header = %q; want header with placeholders", l.Header)
+	}
+	if _, err := db.Header("MIT", licensedb.Vars{}); !errors.Is(err, licensedb.ErrNoHeader) {
+		t.Fatalf("Header(MIT) error = %v; want %v", err, licensedb.ErrNoHeader)
+	}
+	if _, err := db.Header("foo", licensedb.Vars{}); !errors.Is(err, licensedb.ErrUnknownID) {
+		t.Fatalf("Header(foo) error = %v; want %v", err, licensedb.ErrUnknownID)
+	}
+}
+
+func Test_DefaultHeader(t *testing.T) {
+	t.Parallel()
+	if !licensedb.Default().HasMetadata() {
+		t.Skip("embedded license list has no metadata, regenerate it from release with json/")
+	}
+	got, err := licensedb.Header("Apache-2.0", licensedb.Vars{Year: "2024", Holder: "Jane Doe"})
+	if err != nil {
+		t.Fatalf("Header(Apache-2.0) error: %v", err)
+	}
+	if !strings.Contains(got, "Copyright 2024 Jane Doe") || !strings.Contains(got, "Apache License, Version 2.0") {
+		t.Fatalf("Header(Apache-2.0) = %q; want filled Apache-2.0 header", got)
+	}
+}
+
+func Test_CommentStyle(t *testing.T) {
+	t.Parallel()
+	const text = "Copyright 2024 Jane Doe\n\nLicensed under the Apache License, Version 2.0."
+	cases := []struct {
+		lang string
+		want string
+	}{
+		{"go", "// Copyright 2024 Jane Doe\n//\n// Licensed under the\n// Apache License, Version\n// 2.0.\n"},
+		{"main.c", "/*\n * Copyright 2024 Jane Doe\n *\n * Licensed under the\n * Apache License, Version\n * 2.0.\n */\n"},
+		{"Makefile", "# Copyright 2024 Jane Doe\n#\n# Licensed under the Apache\n# License, Version 2.0.\n"},
+		{".HTML", "<!--\nCopyright 2024 Jane Doe\n\nLicensed under the Apache\nLicense, Version 2.0.\n-->\n"},
+		{"sql", "-- Copyright 2024 Jane Doe\n--\n-- Licensed under the\n-- Apache License, Version\n-- 2.0.\n"},
+	}
+	for _, c := range cases {
+		t.Run(c.lang, func(t *testing.T) {
+			t.Parallel()
+			style, ok := licensedb.CommentStyleFor(c.lang)
+			if !ok {
+				t.Fatalf("CommentStyleFor(%v) = _, false; want style", c.lang)
+			}
+			if got := style.Comment(text, 27); got != c.want {
+				t.Fatalf("CommentStyleFor(%v).Comment() = %q; want %q", c.lang, got, c.want)
+			}
+		})
+	}
+	if _, ok := licensedb.CommentStyleFor("README"); ok {
+		t.Fatalf("CommentStyleFor(README) = _, true; want false")
+	}
+
+	src := "#!/bin/sh\necho hi\n"
+	want := "#!/bin/sh\n\n# Copyright 2024 Jane Doe\n\necho hi\n"
+	if got := licensedb.HashStyle.Insert(src, "Copyright 2024 Jane Doe", 80); got != want {
+		t.Fatalf("Insert(%q) = %q; want %q", src, got, want)
+	}
+}
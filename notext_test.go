@@ -0,0 +1,49 @@
+//go:build licensedb_notext
+
+package licensedb_test
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/asciimoth/licensedb"
+)
+
+func Test_NoText(t *testing.T) {
+	t.Parallel()
+	if licensedb.Default().HasTexts() {
+		t.Fatalf("HasTexts() = true; want false")
+	}
+	if _, err := licensedb.Text("MIT"); !errors.Is(err, licensedb.ErrNoText) {
+		t.Fatalf("Text(MIT) error = %v; want %v", err, licensedb.ErrNoText)
+	}
+	if _, err := licensedb.OpenText("MIT"); !errors.Is(err, licensedb.ErrNoText) {
+		t.Fatalf("OpenText(MIT) error = %v; want %v", err, licensedb.ErrNoText)
+	}
+	if _, _, _, err := licensedb.GetFiles("MIT"); !errors.Is(err, licensedb.ErrNoText) {
+		t.Fatalf("GetFiles(MIT) error = %v; want %v", err, licensedb.ErrNoText)
+	}
+	if _, err := licensedb.Text("no-such-license"); !errors.Is(err, licensedb.ErrUnknownID) {
+		t.Fatalf("Text(no-such-license) error = %v; want %v", err, licensedb.ErrUnknownID)
+	}
+	if got := licensedb.Normalise("gpl3+ or mit"); got != "GPL-3.0-or-later OR MIT" {
+		t.Fatalf("Normalise(gpl3+ or mit) = %v; want GPL-3.0-or-later OR MIT", got)
+	}
+}
+
+func Test_NoTextFS(t *testing.T) {
+	t.Parallel()
+	fsys := licensedb.FS()
+	if err := fstest.TestFS(fsys, "licenses", "exceptions"); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := fs.ReadDir(fsys, "licenses")
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("ReadDir(licenses) = %d entries, %v; want none", len(entries), err)
+	}
+	if _, err := fs.Stat(fsys, "licenses/MIT.txt"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("Stat(licenses/MIT.txt) error = %v; want %v", err, fs.ErrNotExist)
+	}
+}
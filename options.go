@@ -0,0 +1,65 @@
+package licensedb
+
+import "github.com/asciimoth/licensedb/internal"
+
+// Profile is a named set of ecosystem specific rules for resolving
+// aliases and ambiguous versions to SPDX IDs.
+type Profile string
+
+const (
+	// Built-in loose rules, used when no profile is selected
+	ProfileDefault Profile = ""
+	// Only exact (case-insensitive) SPDX IDs are recognised
+	ProfileSPDXStrict Profile = "spdx-strict"
+	// nixpkgs lib.licenses short names; "gpl2" means GPL-2.0-only
+	ProfileNixpkgs Profile = "nixpkgs"
+	// Gentoo license names; "GPL-2" means GPL-2.0-only
+	ProfileGentoo Profile = "gentoo"
+	// Debian DEP-5 short names; "GPL-2" means GPL-2.0-only
+	ProfileDebian Profile = "debian"
+	// Legacy npm metadata; "GPL-2" means GPL-2.0-or-later
+	ProfileNPM Profile = "npm"
+	// PyPI license fields and classifiers; "GPLv2" means GPL-2.0-only
+	ProfilePyPI Profile = "pypi"
+)
+
+// Option configures functions like Normalise, Extract and GetFiles.
+type Option func(*options)
+
+type options struct {
+	profile *internal.Profile
+	lossy   bool
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.lossy {
+		var p internal.Profile
+		if o.profile != nil {
+			p = *o.profile
+		}
+		p.Lossy = true
+		o.profile = &p
+	}
+	return o
+}
+
+// WithProfile selects name resolution profile.
+// Unknown profiles fall back to ProfileDefault.
+func WithProfile(p Profile) Option {
+	return func(o *options) {
+		o.profile = internal.Profiles[string(p)]
+	}
+}
+
+// WithLossyAliases enables aliases that guess details their spelling
+// doesn't carry, like "BSD" -> "BSD-3-Clause" or "Apache" -> "Apache-2.0".
+// Exact aliases are always enabled. Has no effect with ProfileSPDXStrict.
+func WithLossyAliases() Option {
+	return func(o *options) {
+		o.lossy = true
+	}
+}
@@ -0,0 +1,96 @@
+package licensedb
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// Filter reports whether license should be included in Query results.
+type Filter func(License) bool
+
+// IsOSIApproved is a Filter matching OSI approved licenses.
+func IsOSIApproved(l License) bool { return l.OSIApproved }
+
+// IsFSFLibre is a Filter matching licenses the FSF considers free.
+func IsFSFLibre(l License) bool { return l.FSFLibre }
+
+// IsDeprecated is a Filter matching deprecated licenses and exceptions.
+func IsDeprecated(l License) bool { return l.Deprecated }
+
+// IsException is a Filter matching license exceptions.
+func IsException(l License) bool { return l.IsException }
+
+// InFamily returns Filter matching licenses of family (case-insensitive),
+// like "BSD" or "GPL".
+func InFamily(family string) Filter {
+	return func(l License) bool {
+		return strings.EqualFold(l.Family, family)
+	}
+}
+
+// Not returns Filter matching licenses f doesn't match.
+func Not(f Filter) Filter {
+	return func(l License) bool {
+		return !f(l)
+	}
+}
+
+// AnyOf returns Filter matching licenses at least one of filters matches.
+func AnyOf(filters ...Filter) Filter {
+	return func(l License) bool {
+		for _, f := range filters {
+			if f(l) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Query returns licenses and exceptions matching all filters, sorted by ID.
+// Without filters it returns whole database.
+func (db *DB) Query(filters ...Filter) []License {
+	result := make([]License, 0)
+	for _, file := range db.core.Filenames {
+		m, ok := db.core.GetMeta(file)
+		if !ok {
+			continue
+		}
+		l := db.newLicense(m)
+		matches := true
+		for _, f := range filters {
+			if !f(l) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			result = append(result, l)
+		}
+	}
+	slices.SortStableFunc(result, func(a, b License) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return result
+}
+
+// Query is a wrapper around Default().Query.
+func Query(filters ...Filter) []License {
+	return Default().Query(filters...)
+}
+
+// List returns IDs of all licenses and exceptions, sorted.
+func (db *DB) List() []string {
+	all := db.Query()
+	ids := make([]string, len(all))
+	for i, l := range all {
+		ids[i] = l.ID
+	}
+	return ids
+}
+
+// List is a wrapper around Default().List.
+func List() []string {
+	return Default().List()
+}
@@ -0,0 +1,143 @@
+package licensedb
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+// Template is SPDX license template: license text with replaceable parts,
+// like copyright line, and optional parts, like title. It describes
+// variations of text that are still the same license and can produce
+// text with filled in parts.
+type Template struct {
+	// ID of license or exception
+	ID    string
+	Parts []TemplatePart
+}
+
+// Kind of TemplatePart
+type PartKind int
+
+const (
+	// Literal text
+	TextPart PartKind = iota
+	// Replaceable text
+	VarPart
+	// Parts that may be omitted
+	OptionalPart
+)
+
+// TemplatePart is literal text, replaceable text or a group of optional
+// parts of Template.
+type TemplatePart struct {
+	Kind PartKind
+	// Literal text of TextPart
+	Text string
+	// Name of VarPart, its text in license and regular expression
+	// matching allowed replacements
+	Name     string
+	Original string
+	Match    string
+	// Parts of OptionalPart
+	Parts []TemplatePart
+}
+
+func newTemplateParts(nodes []internal.TemplateNode) []TemplatePart {
+	parts := make([]TemplatePart, len(nodes))
+	for i, n := range nodes {
+		parts[i] = TemplatePart{
+			Kind:     PartKind(n.Kind),
+			Text:     n.Text,
+			Name:     n.Name,
+			Original: n.Original,
+			Match:    n.Match,
+		}
+		if n.Children != nil {
+			parts[i].Parts = newTemplateParts(n.Children)
+		}
+	}
+	return parts
+}
+
+func templateNodes(parts []TemplatePart) []internal.TemplateNode {
+	nodes := make([]internal.TemplateNode, len(parts))
+	for i, p := range parts {
+		nodes[i] = internal.TemplateNode{
+			Kind:     internal.NodeKind(p.Kind),
+			Text:     p.Text,
+			Name:     p.Name,
+			Original: p.Original,
+			Match:    p.Match,
+		}
+		if p.Parts != nil {
+			nodes[i].Children = templateNodes(p.Parts)
+		}
+	}
+	return nodes
+}
+
+// ParseTemplate parses license template in SPDX format with
+// <<var;name="...";original="...";match="...">> and
+// <<beginOptional>>...<<endOptional>> markup.
+func ParseTemplate(text string) (Template, error) {
+	nodes, err := internal.ParseTemplate(text)
+	if err != nil {
+		return Template{}, err
+	}
+	return Template{Parts: newTemplateParts(nodes)}, nil
+}
+
+// Template returns template of license or exception id. Alternative
+// forms of ID like "gpl3+" are accepted. ErrNoTemplate is returned if
+// there is no template for id.
+func (db *DB) Template(id string) (Template, error) {
+	file, ok := db.core.ResolveFile(id)
+	if !ok {
+		return Template{}, fmt.Errorf("%w: %s", ErrUnknownID, id)
+	}
+	text, err := db.core.ReadTemplate(file)
+	if err != nil {
+		return Template{}, err
+	}
+	t, err := ParseTemplate(text)
+	if err != nil {
+		return Template{}, fmt.Errorf("%s: %w", file, err)
+	}
+	t.ID = strings.TrimPrefix(file, "deprecated_")
+	return t, nil
+}
+
+// Vars returns names of replaceable parts of t in order of appearance,
+// without repeats.
+func (t Template) Vars() []string {
+	var names []string
+	seen := make(map[string]bool)
+	var walk func(parts []TemplatePart)
+	walk = func(parts []TemplatePart) {
+		for _, p := range parts {
+			if p.Kind == VarPart && !seen[p.Name] {
+				seen[p.Name] = true
+				names = append(names, p.Name)
+			}
+			walk(p.Parts)
+		}
+	}
+	walk(t.Parts)
+	return names
+}
+
+// Fill returns text of t with replaceable parts set to vars by their
+// names, like "copyright". Parts missing from vars keep their original
+// text. Optional parts are kept.
+func (t Template) Fill(vars map[string]string) string {
+	return internal.FillTemplate(templateNodes(t.Parts), vars)
+}
+
+// Regexp returns regular expression matching texts of t, ignoring case
+// and differences in whitespace. Compile it once to match many texts.
+func (t Template) Regexp() (*regexp.Regexp, error) {
+	return regexp.Compile(internal.TemplatePattern(templateNodes(t.Parts)))
+}
@@ -0,0 +1,52 @@
+package licensedb
+
+import (
+	"fmt"
+
+	"github.com/asciimoth/licensedb/internal"
+)
+
+// Number of unchanged lines shown around changes in text diffs
+const diffContext = 3
+
+// DiffText returns unified diff of text of license or exception id
+// between a and b, where a is the older database. Result is empty if
+// texts are equal. Alternative forms of ID like "gpl3+" are accepted.
+func DiffText[S ~string](a, b *DB, id S) (string, error) {
+	return diffText(a, b, string(id), internal.UnifiedDiff)
+}
+
+// DiffTextWords is like DiffText, but shows changed lines as running
+// text with removed words marked as [-words-] and added as {+words+}.
+func DiffTextWords[S ~string](a, b *DB, id S) (string, error) {
+	return diffText(a, b, string(id), internal.WordDiff)
+}
+
+func diffText(
+	a, b *DB, id string,
+	diff func(oldName, newName, old, new string, context int) string,
+) (string, error) {
+	old, oldName, err := a.diffSide(id)
+	if err != nil {
+		return "", err
+	}
+	new, newName, err := b.diffSide(id)
+	if err != nil {
+		return "", err
+	}
+	return diff(oldName, newName, old, new, diffContext), nil
+}
+
+// diffSide returns text of id and its name for diff header
+func (db *DB) diffSide(id string) (string, string, error) {
+	m, ok := db.core.GetMeta(id)
+	if !ok {
+		return "", "", fmt.Errorf("%w: %s in license list %s", ErrUnknownID, id, versionOrUnknown(db.ListVersion()))
+	}
+	file, _ := db.core.ResolveFile(id)
+	text, err := db.core.ReadText(file)
+	if err != nil {
+		return "", "", err
+	}
+	return text, m.ID + " " + versionOrUnknown(db.ListVersion()), nil
+}